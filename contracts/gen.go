@@ -31,6 +31,7 @@ package contracts
 //go:generate abigen --pkg distribution --abi ./out/Distribution.sol/IDistributionModule.abi.json --bin ./out/Distribution.sol/IDistributionModule.bin --out ./bindings/cosmos/precompile/distribution/i_distribution_module.abigen.go --type DistributionModule --exc "IBankModuleCoin"
 //go:generate abigen --pkg governance --abi ./out/Governance.sol/IGovernanceModule.abi.json --bin ./out/Governance.sol/IGovernanceModule.bin --out ./bindings/cosmos/precompile/governance/i_governance_module.abigen.go --type GovernanceModule
 //go:generate abigen --pkg erc20 --abi ./out/ERC20Module.sol/IERC20Module.abi.json --bin ./out/ERC20Module.sol/IERC20Module.bin --out ./bindings/cosmos/precompile/erc20/i_erc20_module.abigen.go --type ERC20Module
+//go:generate abigen --pkg ibc --abi ./out/IBCTransfer.sol/IIBCTransferModule.abi.json --bin ./out/IBCTransfer.sol/IIBCTransferModule.bin --out ./bindings/cosmos/precompile/ibc/i_ibc_transfer_module.abigen.go --type IBCTransferModule
 
 //go:generate abigen --pkg cosmos --abi ./out/PolarisERC20.sol/PolarisERC20.abi.json --bin ./out/PolarisERC20.sol/PolarisERC20.bin --out ./bindings/cosmos/polaris_erc20.abigen.go --type PolarisERC20
 
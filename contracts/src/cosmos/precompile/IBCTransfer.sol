@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the ICS-20 IBC transfer module's precompiled contract
+ */
+interface IIBCTransferModule {
+    ////////////////////////////////////////// Write Methods /////////////////////////////////////////////
+    /**
+     * @dev Transfer `amount` of `denom` from the caller (msg.sender) to `receiver` on the chain at the
+     * other end of `sourceChannel`. Returns the sequence of the sent packet.
+     * @param sourcePort The port on which the packet is sent.
+     * @param sourceChannel The channel on which the packet is sent.
+     * @param denom The denomination of the tokens to transfer.
+     * @param amount The amount of tokens to transfer.
+     * @param receiver The address of the receiver on the counterparty chain.
+     * @param timeoutHeight The height of the counterparty chain after which the packet times out.
+     * @param timeoutTimestamp The timestamp (in nanoseconds) of the counterparty chain after which the
+     * packet times out.
+     * @param memo The memo to attach to the packet.
+     */
+    function transfer(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        string calldata denom,
+        uint256 amount,
+        string calldata receiver,
+        Height calldata timeoutHeight,
+        uint64 timeoutTimestamp,
+        string calldata memo
+    ) external returns (uint64);
+
+    ////////////////////////////////////////// Read Methods /////////////////////////////////////////////
+
+    /**
+     * @dev Get the denomination trace of the given hash.
+     * @param hash The hash of the denomination trace, with or without the `ibc/` prefix.
+     */
+    function getDenomTrace(string calldata hash) external view returns (DenomTrace memory);
+
+    /**
+     * @dev Get all the denomination traces.
+     */
+    function getDenomTraces() external view returns (DenomTrace[] memory);
+
+    /**
+     * @dev Get the hash of the given denomination trace.
+     * @param trace The denomination trace, e.g. `transfer/channel-0/uatom`.
+     */
+    function getDenomHash(string calldata trace) external view returns (string memory);
+
+    /**
+     * @dev Get the channel with the given port and channel ids.
+     * @param portId The id of the port of the channel.
+     * @param channelId The id of the channel.
+     */
+    function getChannel(string calldata portId, string calldata channelId) external view returns (Channel memory);
+
+    /**
+     * @dev Get all the channels.
+     */
+    function getChannels() external view returns (Channel[] memory);
+
+    ////////////////////////////////////////// Structs ///////////////////////////////////////////////////
+    /**
+     * @dev Represents an IBC client `Height`.
+     * Note: this struct is generated in generated/i_ibc_transfer_module.abigen.go
+     */
+    struct Height {
+        uint64 revisionNumber;
+        uint64 revisionHeight;
+    }
+
+    /**
+     * @dev Represents an IBC transfer module `DenomTrace`.
+     * Note: this struct is generated in generated/i_ibc_transfer_module.abigen.go
+     */
+    struct DenomTrace {
+        string path;
+        string baseDenom;
+    }
+
+    /**
+     * @dev Represents an IBC `Channel`, with its port and channel ids.
+     * Note: this struct is generated in generated/i_ibc_transfer_module.abigen.go
+     */
+    struct Channel {
+        string portId;
+        string channelId;
+        int32 state;
+        int32 ordering;
+        string counterpartyPortId;
+        string counterpartyChannelId;
+        string[] connectionHops;
+        string version;
+    }
+
+    ////////////////////////////////////////// Events ////////////////////////////////////////////////////
+    /**
+     * @dev Emitted by the IBC transfer module when `amount` of `denom` is sent by `sender` to
+     * `receiver` on the counterparty chain.
+     * @param sender The address of the sender.
+     * @param receiver The address of the receiver on the counterparty chain.
+     * @param amount The amount of tokens sent.
+     * @param denom The denomination of the tokens sent.
+     * @param memo The memo attached to the packet.
+     */
+    event IbcTransfer(address indexed sender, string receiver, uint256 amount, string denom, string memo);
+
+    /**
+     * @dev Emitted by the IBC transfer module when a fungible token packet is received, or when
+     * the acknowledgement of a sent packet is received.
+     * @param sender The sender of the tokens.
+     * @param receiver The receiver of the tokens.
+     * @param amount The amount of tokens in the packet.
+     * @param denom The denomination of the tokens in the packet.
+     * @param memo The memo attached to the packet.
+     */
+    event FungibleTokenPacket(string sender, string receiver, uint256 amount, string denom, string memo);
+
+    /**
+     * @dev Emitted by the IBC channel when the acknowledgement of a sent packet is received.
+     * @param packetSequence The sequence of the packet.
+     * @param packetSrcPort The source port of the packet.
+     * @param packetSrcChannel The source channel of the packet.
+     * @param packetDstPort The destination port of the packet.
+     * @param packetDstChannel The destination channel of the packet.
+     * @param packetTimeoutHeight The timeout height of the packet, as `revision-height`.
+     * @param packetTimeoutTimestamp The timeout timestamp of the packet, in nanoseconds.
+     * @param packetChannelOrdering The ordering of the channel of the packet.
+     * @param packetConnection The connection of the channel of the packet.
+     */
+    event AcknowledgePacket(
+        uint64 packetSequence,
+        string packetSrcPort,
+        string packetSrcChannel,
+        string packetDstPort,
+        string packetDstChannel,
+        string packetTimeoutHeight,
+        uint64 packetTimeoutTimestamp,
+        string packetChannelOrdering,
+        string packetConnection
+    );
+
+    /**
+     * @dev Emitted by the IBC transfer module when a sent packet times out and the tokens are
+     * refunded to `refundReceiver`.
+     * @param refundReceiver The receiver of the refunded tokens.
+     * @param refundAmount The amount of tokens refunded.
+     * @param refundDenom The denomination of the tokens refunded.
+     * @param memo The memo attached to the packet.
+     */
+    event Timeout(string refundReceiver, uint256 refundAmount, string refundDenom, string memo);
+}
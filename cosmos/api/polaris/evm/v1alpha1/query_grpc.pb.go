@@ -0,0 +1,482 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: polaris/evm/v1alpha1/query.proto
+
+package evmv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	QueryService_Account_FullMethodName       = "/polaris.evm.v1alpha1.QueryService/Account"
+	QueryService_Code_FullMethodName          = "/polaris.evm.v1alpha1.QueryService/Code"
+	QueryService_Storage_FullMethodName       = "/polaris.evm.v1alpha1.QueryService/Storage"
+	QueryService_EthCall_FullMethodName       = "/polaris.evm.v1alpha1.QueryService/EthCall"
+	QueryService_EstimateGas_FullMethodName   = "/polaris.evm.v1alpha1.QueryService/EstimateGas"
+	QueryService_FeeSuggestion_FullMethodName = "/polaris.evm.v1alpha1.QueryService/FeeSuggestion"
+	QueryService_ChainConfig_FullMethodName   = "/polaris.evm.v1alpha1.QueryService/ChainConfig"
+	QueryService_BlockByNumber_FullMethodName = "/polaris.evm.v1alpha1.QueryService/BlockByNumber"
+	QueryService_Receipt_FullMethodName       = "/polaris.evm.v1alpha1.QueryService/Receipt"
+	QueryService_Params_FullMethodName        = "/polaris.evm.v1alpha1.QueryService/Params"
+)
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	// Account queries the balance, nonce, and code hash of an account.
+	Account(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
+	// Code queries the code of an account.
+	Code(ctx context.Context, in *CodeRequest, opts ...grpc.CallOption) (*CodeResponse, error)
+	// Storage queries the value of a storage slot of an account.
+	Storage(ctx context.Context, in *StorageRequest, opts ...grpc.CallOption) (*StorageResponse, error)
+	// EthCall executes a message call without creating a transaction.
+	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EthCallResponse, error)
+	// EstimateGas estimates the gas needed to execute a transaction.
+	EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// FeeSuggestion queries the suggested gas tip cap and the base fee of the next block.
+	FeeSuggestion(ctx context.Context, in *FeeSuggestionRequest, opts ...grpc.CallOption) (*FeeSuggestionResponse, error)
+	// ChainConfig queries the Ethereum chain config.
+	ChainConfig(ctx context.Context, in *ChainConfigRequest, opts ...grpc.CallOption) (*ChainConfigResponse, error)
+	// BlockByNumber queries the Ethereum block of a number.
+	BlockByNumber(ctx context.Context, in *BlockByNumberRequest, opts ...grpc.CallOption) (*BlockByNumberResponse, error)
+	// Receipt queries the Ethereum receipt of a transaction.
+	Receipt(ctx context.Context, in *ReceiptRequest, opts ...grpc.CallOption) (*ReceiptResponse, error)
+	// Params queries the parameters of the x/evm module.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryServiceClient(cc grpc.ClientConnInterface) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) Account(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, QueryService_Account_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Code(ctx context.Context, in *CodeRequest, opts ...grpc.CallOption) (*CodeResponse, error) {
+	out := new(CodeResponse)
+	err := c.cc.Invoke(ctx, QueryService_Code_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Storage(ctx context.Context, in *StorageRequest, opts ...grpc.CallOption) (*StorageResponse, error) {
+	out := new(StorageResponse)
+	err := c.cc.Invoke(ctx, QueryService_Storage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EthCallResponse, error) {
+	out := new(EthCallResponse)
+	err := c.cc.Invoke(ctx, QueryService_EthCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
+	out := new(EstimateGasResponse)
+	err := c.cc.Invoke(ctx, QueryService_EstimateGas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) FeeSuggestion(ctx context.Context, in *FeeSuggestionRequest, opts ...grpc.CallOption) (*FeeSuggestionResponse, error) {
+	out := new(FeeSuggestionResponse)
+	err := c.cc.Invoke(ctx, QueryService_FeeSuggestion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ChainConfig(ctx context.Context, in *ChainConfigRequest, opts ...grpc.CallOption) (*ChainConfigResponse, error) {
+	out := new(ChainConfigResponse)
+	err := c.cc.Invoke(ctx, QueryService_ChainConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) BlockByNumber(ctx context.Context, in *BlockByNumberRequest, opts ...grpc.CallOption) (*BlockByNumberResponse, error) {
+	out := new(BlockByNumberResponse)
+	err := c.cc.Invoke(ctx, QueryService_BlockByNumber_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Receipt(ctx context.Context, in *ReceiptRequest, opts ...grpc.CallOption) (*ReceiptResponse, error) {
+	out := new(ReceiptResponse)
+	err := c.cc.Invoke(ctx, QueryService_Receipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, QueryService_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+// All implementations must embed UnimplementedQueryServiceServer
+// for forward compatibility
+type QueryServiceServer interface {
+	// Account queries the balance, nonce, and code hash of an account.
+	Account(context.Context, *AccountRequest) (*AccountResponse, error)
+	// Code queries the code of an account.
+	Code(context.Context, *CodeRequest) (*CodeResponse, error)
+	// Storage queries the value of a storage slot of an account.
+	Storage(context.Context, *StorageRequest) (*StorageResponse, error)
+	// EthCall executes a message call without creating a transaction.
+	EthCall(context.Context, *EthCallRequest) (*EthCallResponse, error)
+	// EstimateGas estimates the gas needed to execute a transaction.
+	EstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error)
+	// FeeSuggestion queries the suggested gas tip cap and the base fee of the next block.
+	FeeSuggestion(context.Context, *FeeSuggestionRequest) (*FeeSuggestionResponse, error)
+	// ChainConfig queries the Ethereum chain config.
+	ChainConfig(context.Context, *ChainConfigRequest) (*ChainConfigResponse, error)
+	// BlockByNumber queries the Ethereum block of a number.
+	BlockByNumber(context.Context, *BlockByNumberRequest) (*BlockByNumberResponse, error)
+	// Receipt queries the Ethereum receipt of a transaction.
+	Receipt(context.Context, *ReceiptRequest) (*ReceiptResponse, error)
+	// Params queries the parameters of the x/evm module.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	mustEmbedUnimplementedQueryServiceServer()
+}
+
+// UnimplementedQueryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (UnimplementedQueryServiceServer) Account(context.Context, *AccountRequest) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
+func (UnimplementedQueryServiceServer) Code(context.Context, *CodeRequest) (*CodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
+}
+func (UnimplementedQueryServiceServer) Storage(context.Context, *StorageRequest) (*StorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
+}
+func (UnimplementedQueryServiceServer) EthCall(context.Context, *EthCallRequest) (*EthCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthCall not implemented")
+}
+func (UnimplementedQueryServiceServer) EstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
+}
+func (UnimplementedQueryServiceServer) FeeSuggestion(context.Context, *FeeSuggestionRequest) (*FeeSuggestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSuggestion not implemented")
+}
+func (UnimplementedQueryServiceServer) ChainConfig(context.Context, *ChainConfigRequest) (*ChainConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainConfig not implemented")
+}
+func (UnimplementedQueryServiceServer) BlockByNumber(context.Context, *BlockByNumberRequest) (*BlockByNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockByNumber not implemented")
+}
+func (UnimplementedQueryServiceServer) Receipt(context.Context, *ReceiptRequest) (*ReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receipt not implemented")
+}
+func (UnimplementedQueryServiceServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServiceServer) mustEmbedUnimplementedQueryServiceServer() {}
+
+// UnsafeQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServiceServer will
+// result in compilation errors.
+type UnsafeQueryServiceServer interface {
+	mustEmbedUnimplementedQueryServiceServer()
+}
+
+func RegisterQueryServiceServer(s grpc.ServiceRegistrar, srv QueryServiceServer) {
+	s.RegisterService(&QueryService_ServiceDesc, srv)
+}
+
+func _QueryService_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_Account_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Account(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Code(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_Code_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Code(ctx, req.(*CodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Storage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Storage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_Storage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Storage(ctx, req.(*StorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_EthCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).EthCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_EthCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).EthCall(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_EstimateGas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).EstimateGas(ctx, req.(*EstimateGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_FeeSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).FeeSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_FeeSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).FeeSuggestion(ctx, req.(*FeeSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ChainConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_ChainConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ChainConfig(ctx, req.(*ChainConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_BlockByNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).BlockByNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_BlockByNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).BlockByNumber(ctx, req.(*BlockByNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Receipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Receipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_Receipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Receipt(ctx, req.(*ReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QueryService_ServiceDesc is the grpc.ServiceDesc for QueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "polaris.evm.v1alpha1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Account",
+			Handler:    _QueryService_Account_Handler,
+		},
+		{
+			MethodName: "Code",
+			Handler:    _QueryService_Code_Handler,
+		},
+		{
+			MethodName: "Storage",
+			Handler:    _QueryService_Storage_Handler,
+		},
+		{
+			MethodName: "EthCall",
+			Handler:    _QueryService_EthCall_Handler,
+		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _QueryService_EstimateGas_Handler,
+		},
+		{
+			MethodName: "FeeSuggestion",
+			Handler:    _QueryService_FeeSuggestion_Handler,
+		},
+		{
+			MethodName: "ChainConfig",
+			Handler:    _QueryService_ChainConfig_Handler,
+		},
+		{
+			MethodName: "BlockByNumber",
+			Handler:    _QueryService_BlockByNumber_Handler,
+		},
+		{
+			MethodName: "Receipt",
+			Handler:    _QueryService_Receipt_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _QueryService_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "polaris/evm/v1alpha1/query.proto",
+}
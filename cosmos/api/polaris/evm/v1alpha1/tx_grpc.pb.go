@@ -39,7 +39,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	MsgService_EthTransaction_FullMethodName = "/polaris.evm.v1alpha1.MsgService/EthTransaction"
+	MsgService_EthTransaction_FullMethodName              = "/polaris.evm.v1alpha1.MsgService/EthTransaction"
+	MsgService_UpdateChainConfig_FullMethodName           = "/polaris.evm.v1alpha1.MsgService/UpdateChainConfig"
+	MsgService_UpdateParams_FullMethodName                = "/polaris.evm.v1alpha1.MsgService/UpdateParams"
+	MsgService_ActivateDynamicPrecompile_FullMethodName   = "/polaris.evm.v1alpha1.MsgService/ActivateDynamicPrecompile"
+	MsgService_DeactivateDynamicPrecompile_FullMethodName = "/polaris.evm.v1alpha1.MsgService/DeactivateDynamicPrecompile"
 )
 
 // MsgServiceClient is the client API for MsgService service.
@@ -48,6 +52,18 @@ const (
 type MsgServiceClient interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
+	// UpdateChainConfig defines a governance operation for updating the Ethereum chain config. The
+	// authority is defined in the keeper.
+	UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error)
+	// UpdateParams defines a governance operation for updating the x/evm module parameters. The
+	// authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ActivateDynamicPrecompile defines a governance operation for activating a dynamic precompile
+	// at an address. The authority is defined in the keeper.
+	ActivateDynamicPrecompile(ctx context.Context, in *MsgActivateDynamicPrecompile, opts ...grpc.CallOption) (*MsgActivateDynamicPrecompileResponse, error)
+	// DeactivateDynamicPrecompile defines a governance operation for deactivating the dynamic
+	// precompile at an address. The authority is defined in the keeper.
+	DeactivateDynamicPrecompile(ctx context.Context, in *MsgDeactivateDynamicPrecompile, opts ...grpc.CallOption) (*MsgDeactivateDynamicPrecompileResponse, error)
 }
 
 type msgServiceClient struct {
@@ -67,12 +83,60 @@ func (c *msgServiceClient) EthTransaction(ctx context.Context, in *WrappedEthere
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error) {
+	out := new(MsgUpdateChainConfigResponse)
+	err := c.cc.Invoke(ctx, MsgService_UpdateChainConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, MsgService_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ActivateDynamicPrecompile(ctx context.Context, in *MsgActivateDynamicPrecompile, opts ...grpc.CallOption) (*MsgActivateDynamicPrecompileResponse, error) {
+	out := new(MsgActivateDynamicPrecompileResponse)
+	err := c.cc.Invoke(ctx, MsgService_ActivateDynamicPrecompile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) DeactivateDynamicPrecompile(ctx context.Context, in *MsgDeactivateDynamicPrecompile, opts ...grpc.CallOption) (*MsgDeactivateDynamicPrecompileResponse, error) {
+	out := new(MsgDeactivateDynamicPrecompileResponse)
+	err := c.cc.Invoke(ctx, MsgService_DeactivateDynamicPrecompile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 // All implementations must embed UnimplementedMsgServiceServer
 // for forward compatibility
 type MsgServiceServer interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
+	// UpdateChainConfig defines a governance operation for updating the Ethereum chain config. The
+	// authority is defined in the keeper.
+	UpdateChainConfig(context.Context, *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error)
+	// UpdateParams defines a governance operation for updating the x/evm module parameters. The
+	// authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ActivateDynamicPrecompile defines a governance operation for activating a dynamic precompile
+	// at an address. The authority is defined in the keeper.
+	ActivateDynamicPrecompile(context.Context, *MsgActivateDynamicPrecompile) (*MsgActivateDynamicPrecompileResponse, error)
+	// DeactivateDynamicPrecompile defines a governance operation for deactivating the dynamic
+	// precompile at an address. The authority is defined in the keeper.
+	DeactivateDynamicPrecompile(context.Context, *MsgDeactivateDynamicPrecompile) (*MsgDeactivateDynamicPrecompileResponse, error)
 	mustEmbedUnimplementedMsgServiceServer()
 }
 
@@ -83,6 +147,18 @@ type UnimplementedMsgServiceServer struct {
 func (UnimplementedMsgServiceServer) EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthTransaction not implemented")
 }
+func (UnimplementedMsgServiceServer) UpdateChainConfig(context.Context, *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainConfig not implemented")
+}
+func (UnimplementedMsgServiceServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServiceServer) ActivateDynamicPrecompile(context.Context, *MsgActivateDynamicPrecompile) (*MsgActivateDynamicPrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateDynamicPrecompile not implemented")
+}
+func (UnimplementedMsgServiceServer) DeactivateDynamicPrecompile(context.Context, *MsgDeactivateDynamicPrecompile) (*MsgDeactivateDynamicPrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDynamicPrecompile not implemented")
+}
 func (UnimplementedMsgServiceServer) mustEmbedUnimplementedMsgServiceServer() {}
 
 // UnsafeMsgServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -114,6 +190,78 @@ func _MsgService_EthTransaction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_UpdateChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateChainConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_UpdateChainConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateChainConfig(ctx, req.(*MsgUpdateChainConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ActivateDynamicPrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateDynamicPrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ActivateDynamicPrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_ActivateDynamicPrecompile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ActivateDynamicPrecompile(ctx, req.(*MsgActivateDynamicPrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DeactivateDynamicPrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateDynamicPrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DeactivateDynamicPrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_DeactivateDynamicPrecompile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DeactivateDynamicPrecompile(ctx, req.(*MsgDeactivateDynamicPrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MsgService_ServiceDesc is the grpc.ServiceDesc for MsgService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -125,6 +273,22 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EthTransaction",
 			Handler:    _MsgService_EthTransaction_Handler,
 		},
+		{
+			MethodName: "UpdateChainConfig",
+			Handler:    _MsgService_UpdateChainConfig_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ActivateDynamicPrecompile",
+			Handler:    _MsgService_ActivateDynamicPrecompile_Handler,
+		},
+		{
+			MethodName: "DeactivateDynamicPrecompile",
+			Handler:    _MsgService_DeactivateDynamicPrecompile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "polaris/evm/v1alpha1/tx.proto",
@@ -39,6 +39,8 @@ import (
 	"pkg.berachain.dev/polaris/eth/core/vm"
 )
 
+//go:generate go run pkg.berachain.dev/polaris/eth/core/precompile/precompilegen --abi ../../../contracts/out/ERC20Module.sol/IERC20Module.abi.json --pkg erc20 --out ./erc20.precompilegen.go
+
 // Contract is the precompile contract for the auth module.
 type Contract struct {
 	ethprecompile.BaseContract
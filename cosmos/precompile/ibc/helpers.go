@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ibc
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/ibc"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+)
+
+// transferHelper is a helper function for the `Transfer` method of the IBC transfer precompile
+// contract.
+func (c *Contract) transferHelper(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	sourcePort, sourceChannel string,
+	token sdk.Coin,
+	receiver string,
+	timeoutHeight Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	// The transfer msg server emits a `message` event with only the `module` attribute, which
+	// cannot be built into the `Message(address)` log of the bank precompile. The transfer is
+	// executed with its own event manager, so that only the other events are emitted.
+	polarCtx := vm.UnwrapPolarContext(ctx)
+	sdkCtx := sdk.UnwrapSDKContext(polarCtx.Context())
+	transferCtx := sdkCtx.WithEventManager(sdk.NewEventManager())
+
+	sequence, err := c.transferKeeper.Transfer(
+		vm.NewPolarContext(transferCtx, polarCtx.Evm(), polarCtx.MsgSender(), polarCtx.MsgValue()),
+		sender,
+		sourcePort,
+		sourceChannel,
+		token,
+		receiver,
+		timeoutHeight,
+		timeoutTimestamp,
+		memo,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range transferCtx.EventManager().Events() {
+		if event.Type != sdk.EventTypeMessage {
+			sdkCtx.EventManager().EmitEvent(event)
+		}
+	}
+
+	return sequence, nil
+}
+
+// transformDenomTraceToABIDenomTrace is a helper function to transform a `DenomTrace` into an ABI
+// compatible struct.
+func transformDenomTraceToABIDenomTrace(trace DenomTrace) generated.IIBCTransferModuleDenomTrace {
+	return generated.IIBCTransferModuleDenomTrace{
+		Path:      trace.Path,
+		BaseDenom: trace.BaseDenom,
+	}
+}
+
+// transformChannelToABIChannel is a helper function to transform a `Channel` into an ABI
+// compatible struct.
+func transformChannelToABIChannel(channel Channel) generated.IIBCTransferModuleChannel {
+	return generated.IIBCTransferModuleChannel{
+		PortId:                channel.PortID,
+		ChannelId:             channel.ChannelID,
+		State:                 channel.State,
+		Ordering:              channel.Ordering,
+		CounterpartyPortId:    channel.Counterparty.PortID,
+		CounterpartyChannelId: channel.Counterparty.ChannelID,
+		ConnectionHops:        channel.ConnectionHops,
+		Version:               channel.Version,
+	}
+}
@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ibc
+
+import (
+	"context"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/ibc"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/precompile/log"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+)
+
+// The ICS-20 module name, event types and attribute keys, as defined by ibc-go.
+const (
+	ModuleName = "transfer"
+
+	EventTypeTransfer = "ibc_transfer"
+	EventTypePacket   = "fungible_token_packet"
+	EventTypeTimeout  = "timeout"
+
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyMemo           = "memo"
+	AttributeKeyRefundReceiver = "refund_receiver"
+	AttributeKeyRefundDenom    = "refund_denom"
+	AttributeKeyRefundAmount   = "refund_amount"
+)
+
+// The IBC core channel event type and attribute keys of the acknowledged packets, as defined by
+// ibc-go.
+const (
+	EventTypeAcknowledgePacket = "acknowledge_packet"
+
+	AttributeKeySequence         = "packet_sequence"
+	AttributeKeySrcPort          = "packet_src_port"
+	AttributeKeySrcChannel       = "packet_src_channel"
+	AttributeKeyDstPort          = "packet_dst_port"
+	AttributeKeyDstChannel       = "packet_dst_channel"
+	AttributeKeyTimeoutHeight    = "packet_timeout_height"
+	AttributeKeyTimeoutTimestamp = "packet_timeout_timestamp"
+	AttributeKeyChannelOrdering  = "packet_channel_ordering"
+	AttributeKeyConnection       = "packet_connection"
+)
+
+// Contract is the precompile contract for the ICS-20 IBC transfer module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	transferKeeper TransferKeeper
+	channelKeeper  ChannelKeeper
+}
+
+// NewPrecompileContract returns a new instance of the IBC transfer module precompile contract. The
+// keepers are provided by the app, on top of its ibc-go modules, so the precompile is only
+// registered by the apps that have them.
+func NewPrecompileContract(tk TransferKeeper, ck ChannelKeeper) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.IBCTransferModuleMetaData.ABI,
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(ModuleName)),
+		),
+		transferKeeper: tk,
+		channelKeeper:  ck,
+	}
+}
+
+// EventValueDecoders implements the `log.EventValueDecodersProvider` interface. The senders and
+// receivers of the packets can be addresses of the counterparty chain, and the ICS-20 amounts are
+// integers instead of coins.
+func (c *Contract) EventValueDecoders() map[string]ethprecompile.ValueDecoders {
+	return map[string]ethprecompile.ValueDecoders{
+		EventTypeTransfer: {
+			AttributeKeyReceiver: log.ReturnStringAsIs,
+			AttributeKeyAmount:   log.ConvertBigInt,
+			AttributeKeyDenom:    log.ReturnStringAsIs,
+			AttributeKeyMemo:     log.ReturnStringAsIs,
+		},
+		EventTypePacket: {
+			sdk.AttributeKeySender: log.ReturnStringAsIs,
+			AttributeKeyReceiver:   log.ReturnStringAsIs,
+			AttributeKeyAmount:     log.ConvertBigInt,
+			AttributeKeyDenom:      log.ReturnStringAsIs,
+			AttributeKeyMemo:       log.ReturnStringAsIs,
+		},
+		EventTypeTimeout: {
+			AttributeKeyRefundReceiver: log.ReturnStringAsIs,
+			AttributeKeyRefundAmount:   log.ConvertBigInt,
+			AttributeKeyRefundDenom:    log.ReturnStringAsIs,
+			AttributeKeyMemo:           log.ReturnStringAsIs,
+		},
+		EventTypeAcknowledgePacket: {
+			AttributeKeySequence:         log.ConvertUint64,
+			AttributeKeySrcPort:          log.ReturnStringAsIs,
+			AttributeKeySrcChannel:       log.ReturnStringAsIs,
+			AttributeKeyDstPort:          log.ReturnStringAsIs,
+			AttributeKeyDstChannel:       log.ReturnStringAsIs,
+			AttributeKeyTimeoutHeight:    log.ReturnStringAsIs,
+			AttributeKeyTimeoutTimestamp: log.ConvertUint64,
+			AttributeKeyChannelOrdering:  log.ReturnStringAsIs,
+			AttributeKeyConnection:       log.ReturnStringAsIs,
+		},
+	}
+}
+
+// Transfer implements the
+// `transfer(string,string,string,uint256,string,(uint64,uint64),uint64,string)` method.
+func (c *Contract) Transfer(
+	ctx context.Context,
+	sourcePort string,
+	sourceChannel string,
+	denom string,
+	amount *big.Int,
+	receiver string,
+	timeoutHeight generated.IIBCTransferModuleHeight,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	return c.transferHelper(
+		ctx,
+		cosmlib.AddressToAccAddress(vm.UnwrapPolarContext(ctx).MsgSender()),
+		sourcePort,
+		sourceChannel,
+		sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)},
+		receiver,
+		Height{
+			RevisionNumber: timeoutHeight.RevisionNumber,
+			RevisionHeight: timeoutHeight.RevisionHeight,
+		},
+		timeoutTimestamp,
+		memo,
+	)
+}
+
+// GetDenomTrace implements the `getDenomTrace(string)` method.
+func (c *Contract) GetDenomTrace(
+	ctx context.Context,
+	hash string,
+) (generated.IIBCTransferModuleDenomTrace, error) {
+	trace, err := c.transferKeeper.DenomTrace(ctx, hash)
+	if err != nil {
+		return generated.IIBCTransferModuleDenomTrace{}, err
+	}
+	return transformDenomTraceToABIDenomTrace(trace), nil
+}
+
+// GetDenomTraces implements the `getDenomTraces()` method.
+func (c *Contract) GetDenomTraces(
+	ctx context.Context,
+) ([]generated.IIBCTransferModuleDenomTrace, error) {
+	traces, err := c.transferKeeper.DenomTraces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	abiTraces := make([]generated.IIBCTransferModuleDenomTrace, len(traces))
+	for i, trace := range traces {
+		abiTraces[i] = transformDenomTraceToABIDenomTrace(trace)
+	}
+	return abiTraces, nil
+}
+
+// GetDenomHash implements the `getDenomHash(string)` method.
+func (c *Contract) GetDenomHash(
+	ctx context.Context,
+	trace string,
+) (string, error) {
+	return c.transferKeeper.DenomHash(ctx, trace)
+}
+
+// GetChannel implements the `getChannel(string,string)` method.
+func (c *Contract) GetChannel(
+	ctx context.Context,
+	portID string,
+	channelID string,
+) (generated.IIBCTransferModuleChannel, error) {
+	channel, err := c.channelKeeper.Channel(ctx, portID, channelID)
+	if err != nil {
+		return generated.IIBCTransferModuleChannel{}, err
+	}
+	return transformChannelToABIChannel(channel), nil
+}
+
+// GetChannels implements the `getChannels()` method.
+func (c *Contract) GetChannels(
+	ctx context.Context,
+) ([]generated.IIBCTransferModuleChannel, error) {
+	channels, err := c.channelKeeper.Channels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	abiChannels := make([]generated.IIBCTransferModuleChannel, len(channels))
+	for i, channel := range channels {
+		abiChannels[i] = transformChannelToABIChannel(channel)
+	}
+	return abiChannels, nil
+}
@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ibc
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/ibc"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/types"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/precompile/log"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestIBCPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/ibc")
+}
+
+var _ = Describe("IBC Transfer Precompile", func() {
+	var (
+		sdkCtx   sdk.Context
+		ctx      context.Context
+		tk       *mockTransferKeeper
+		ck       *mockChannelKeeper
+		contract *Contract
+		factory  *log.Factory
+	)
+
+	BeforeEach(func() {
+		types.SetupCosmosConfig()
+		sdkCtx, _, _, _ = testutils.SetupMinimalKeepers()
+		ctx = vm.NewPolarContext(sdkCtx, nil, testutils.Alice, big.NewInt(0))
+		tk = &mockTransferKeeper{}
+		ck = &mockChannelKeeper{}
+		contract = NewPrecompileContract(tk, ck)
+		factory = log.NewFactory([]ethprecompile.Registrable{contract})
+	})
+
+	It("should build the precompile", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should register the ibc transfer event", func() {
+		event := sdk.NewEvent(
+			EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, cosmlib.AddressToAccAddress(testutils.Alice).String()),
+			sdk.NewAttribute(AttributeKeyReceiver, "cosmos1receiver"),
+			sdk.NewAttribute(AttributeKeyAmount, "100000000000000000000"),
+			sdk.NewAttribute(AttributeKeyDenom, "abera"),
+			sdk.NewAttribute(AttributeKeyMemo, "memo"),
+		)
+		log, err := factory.Build(&event)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(log.Address).To(Equal(contract.RegistryKey()))
+		Expect(log.Topics).To(HaveLen(2))
+	})
+
+	It("should register the fungible token packet event", func() {
+		event := sdk.NewEvent(
+			EventTypePacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, "cosmos1sender"),
+			sdk.NewAttribute(AttributeKeyReceiver, cosmlib.AddressToAccAddress(testutils.Alice).String()),
+			sdk.NewAttribute(AttributeKeyDenom, "transfer/channel-0/uatom"),
+			sdk.NewAttribute(AttributeKeyAmount, "100"),
+			sdk.NewAttribute(AttributeKeyMemo, "memo"),
+			sdk.NewAttribute("success", "true"),
+		)
+		log, err := factory.Build(&event)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(log.Address).To(Equal(contract.RegistryKey()))
+		Expect(log.Topics).To(HaveLen(1))
+
+		values, err := contract.ABIEvents()["FungibleTokenPacket"].Inputs.Unpack(log.Data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(Equal([]any{
+			"cosmos1sender",
+			cosmlib.AddressToAccAddress(testutils.Alice).String(),
+			big.NewInt(100),
+			"transfer/channel-0/uatom",
+			"memo",
+		}))
+	})
+
+	It("should register the acknowledge packet event", func() {
+		event := sdk.NewEvent(
+			EventTypeAcknowledgePacket,
+			sdk.NewAttribute(AttributeKeyTimeoutHeight, "1-100"),
+			sdk.NewAttribute(AttributeKeyTimeoutTimestamp, "1000"),
+			sdk.NewAttribute(AttributeKeySequence, "7"),
+			sdk.NewAttribute(AttributeKeySrcPort, "transfer"),
+			sdk.NewAttribute(AttributeKeySrcChannel, "channel-0"),
+			sdk.NewAttribute(AttributeKeyDstPort, "transfer"),
+			sdk.NewAttribute(AttributeKeyDstChannel, "channel-1"),
+			sdk.NewAttribute(AttributeKeyChannelOrdering, "ORDER_UNORDERED"),
+			sdk.NewAttribute(AttributeKeyConnection, "connection-0"),
+		)
+		log, err := factory.Build(&event)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(log.Address).To(Equal(contract.RegistryKey()))
+
+		values, err := contract.ABIEvents()["AcknowledgePacket"].Inputs.Unpack(log.Data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(Equal([]any{
+			uint64(7), "transfer", "channel-0", "transfer", "channel-1", "1-100", uint64(1000),
+			"ORDER_UNORDERED", "connection-0",
+		}))
+	})
+
+	It("should register the timeout event", func() {
+		refundReceiver := cosmlib.AddressToAccAddress(testutils.Alice).String()
+		event := sdk.NewEvent(
+			EventTypeTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyRefundReceiver, refundReceiver),
+			sdk.NewAttribute(AttributeKeyRefundDenom, "abera"),
+			sdk.NewAttribute(AttributeKeyRefundAmount, "100"),
+			sdk.NewAttribute(AttributeKeyMemo, ""),
+		)
+		log, err := factory.Build(&event)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(log.Address).To(Equal(contract.RegistryKey()))
+
+		values, err := contract.ABIEvents()["Timeout"].Inputs.Unpack(log.Data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(Equal([]any{
+			refundReceiver, big.NewInt(100), "abera", "",
+		}))
+	})
+
+	It("should fail to build an event with an invalid amount", func() {
+		event := sdk.NewEvent(
+			EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, cosmlib.AddressToAccAddress(testutils.Alice).String()),
+			sdk.NewAttribute(AttributeKeyReceiver, "cosmos1receiver"),
+			sdk.NewAttribute(AttributeKeyAmount, "100abera"),
+			sdk.NewAttribute(AttributeKeyDenom, "abera"),
+			sdk.NewAttribute(AttributeKeyMemo, ""),
+		)
+		_, err := factory.Build(&event)
+		Expect(err).To(MatchError(log.ErrInvalidInteger))
+	})
+
+	When("Transferring", func() {
+		It("should transfer from the caller and only emit the transfer events", func() {
+			tk.sequence = 7
+			sequence, err := contract.Transfer(
+				ctx,
+				"transfer",
+				"channel-0",
+				"abera",
+				big.NewInt(100),
+				"cosmos1receiver",
+				generated.IIBCTransferModuleHeight{RevisionNumber: 1, RevisionHeight: 100},
+				0,
+				"memo",
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sequence).To(Equal(uint64(7)))
+			Expect(tk.sender).To(Equal(cosmlib.AddressToAccAddress(testutils.Alice)))
+			Expect(tk.token).To(Equal(sdk.NewInt64Coin("abera", 100)))
+			Expect(tk.timeoutHeight).To(Equal(Height{RevisionNumber: 1, RevisionHeight: 100}))
+
+			events := sdkCtx.EventManager().Events()
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Type).To(Equal(EventTypeTransfer))
+		})
+
+		It("should fail if the transfer fails", func() {
+			tk.err = errors.New("transfer failed")
+			_, err := contract.Transfer(
+				ctx,
+				"transfer",
+				"channel-0",
+				"abera",
+				big.NewInt(100),
+				"cosmos1receiver",
+				generated.IIBCTransferModuleHeight{},
+				1,
+				"",
+			)
+			Expect(err).To(MatchError(tk.err))
+			Expect(sdkCtx.EventManager().Events()).To(BeEmpty())
+		})
+	})
+
+	When("Querying", func() {
+		It("should return the denom traces", func() {
+			tk.traces = []DenomTrace{{Path: "transfer/channel-0", BaseDenom: "uatom"}}
+			trace := generated.IIBCTransferModuleDenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+
+			res, err := contract.GetDenomTrace(ctx, "hash")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(trace))
+
+			traces, err := contract.GetDenomTraces(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(traces).To(Equal([]generated.IIBCTransferModuleDenomTrace{trace}))
+
+			hash, err := contract.GetDenomHash(ctx, "transfer/channel-0/uatom")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash).To(Equal("hash"))
+		})
+
+		It("should return the channels", func() {
+			ck.channels = []Channel{{
+				PortID:         "transfer",
+				ChannelID:      "channel-0",
+				State:          3,
+				Ordering:       1,
+				Counterparty:   Counterparty{PortID: "transfer", ChannelID: "channel-1"},
+				ConnectionHops: []string{"connection-0"},
+				Version:        "ics20-1",
+			}}
+			channel := generated.IIBCTransferModuleChannel{
+				PortId:                "transfer",
+				ChannelId:             "channel-0",
+				State:                 3,
+				Ordering:              1,
+				CounterpartyPortId:    "transfer",
+				CounterpartyChannelId: "channel-1",
+				ConnectionHops:        []string{"connection-0"},
+				Version:               "ics20-1",
+			}
+
+			res, err := contract.GetChannel(ctx, "transfer", "channel-0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(channel))
+
+			channels, err := contract.GetChannels(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(channels).To(Equal([]generated.IIBCTransferModuleChannel{channel}))
+		})
+	})
+})
+
+type mockTransferKeeper struct {
+	sequence uint64
+	err      error
+	sender   sdk.AccAddress
+	token    sdk.Coin
+	traces   []DenomTrace
+
+	timeoutHeight Height
+}
+
+func (m *mockTransferKeeper) Transfer(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	_, _ string,
+	token sdk.Coin,
+	receiver string,
+	timeoutHeight Height,
+	_ uint64,
+	memo string,
+) (uint64, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	m.sender = sender
+	m.token = token
+	m.timeoutHeight = timeoutHeight
+
+	em := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()).EventManager()
+	em.EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+	))
+	em.EmitEvent(sdk.NewEvent(
+		EventTypeTransfer,
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(AttributeKeyAmount, token.Amount.String()),
+		sdk.NewAttribute(AttributeKeyDenom, token.Denom),
+		sdk.NewAttribute(AttributeKeyMemo, memo),
+	))
+	return m.sequence, nil
+}
+
+func (m *mockTransferKeeper) DenomTrace(context.Context, string) (DenomTrace, error) {
+	return m.traces[0], nil
+}
+
+func (m *mockTransferKeeper) DenomTraces(context.Context) ([]DenomTrace, error) {
+	return m.traces, nil
+}
+
+func (m *mockTransferKeeper) DenomHash(context.Context, string) (string, error) {
+	return "hash", nil
+}
+
+type mockChannelKeeper struct {
+	channels []Channel
+}
+
+func (m *mockChannelKeeper) Channel(context.Context, string, string) (Channel, error) {
+	return m.channels[0], nil
+}
+
+func (m *mockChannelKeeper) Channels(context.Context) ([]Channel, error) {
+	return m.channels, nil
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ibc
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// TransferKeeper is the ICS-20 fungible token transfer application used by the IBC transfer
+	// precompile. It is implemented by the app on top of the keeper of its ibc-go transfer module.
+	TransferKeeper interface {
+		// Transfer sends the token from the sender to the receiver on the counterparty chain of
+		// the source channel and returns the sequence of the sent packet.
+		Transfer(
+			ctx context.Context,
+			sender sdk.AccAddress,
+			sourcePort, sourceChannel string,
+			token sdk.Coin,
+			receiver string,
+			timeoutHeight Height,
+			timeoutTimestamp uint64,
+			memo string,
+		) (uint64, error)
+
+		// DenomTrace returns the denomination trace of the given trace hash.
+		DenomTrace(ctx context.Context, hash string) (DenomTrace, error)
+
+		// DenomTraces returns the denomination traces known to the chain.
+		DenomTraces(ctx context.Context) ([]DenomTrace, error)
+
+		// DenomHash returns the hash of the given denomination trace.
+		DenomHash(ctx context.Context, trace string) (string, error)
+	}
+
+	// ChannelKeeper is the IBC core channel querier used by the IBC transfer precompile. It is
+	// implemented by the app on top of the keeper of its ibc-go channels.
+	ChannelKeeper interface {
+		// Channel returns the channel of the given port and channel identifiers.
+		Channel(ctx context.Context, portID, channelID string) (Channel, error)
+
+		// Channels returns the channels of the chain.
+		Channels(ctx context.Context) ([]Channel, error)
+	}
+)
+
+type (
+	// Height is a height of a counterparty chain, as the ibc-go `clienttypes.Height`.
+	Height struct {
+		RevisionNumber uint64
+		RevisionHeight uint64
+	}
+
+	// DenomTrace is the trace of an ICS-20 denomination, as the ibc-go `transfertypes.DenomTrace`.
+	DenomTrace struct {
+		Path      string
+		BaseDenom string
+	}
+
+	// Channel is an IBC channel along with its port and channel identifiers, as the ibc-go
+	// `channeltypes.IdentifiedChannel`. The state and ordering are the values of the ibc-go
+	// `channeltypes.State` and `channeltypes.Order` enums.
+	Channel struct {
+		PortID         string
+		ChannelID      string
+		State          int32
+		Ordering       int32
+		Counterparty   Counterparty
+		ConnectionHops []string
+		Version        string
+	}
+
+	// Counterparty is the end of a channel on the counterparty chain, as the ibc-go
+	// `channeltypes.Counterparty`.
+	Counterparty struct {
+		PortID    string
+		ChannelID string
+	}
+)
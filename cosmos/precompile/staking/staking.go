@@ -36,6 +36,14 @@ import (
 	"pkg.berachain.dev/polaris/eth/core/vm"
 )
 
+const (
+	// lookupGas is the static gas cost of the view methods that look up the entries of a single
+	// delegator or validator, which is the cost of a cold `SLOAD`.
+	lookupGas = 2100
+	// iterationGas is the static gas cost of the view methods that iterate over the validators.
+	iterationGas = 10 * lookupGas
+)
+
 // Contract is the precompile contract for the staking module.
 type Contract struct {
 	ethprecompile.BaseContract
@@ -56,6 +64,20 @@ func NewPrecompileContract(sk *stakingkeeper.Keeper) *Contract {
 	}
 }
 
+// GasSchedule implements the `ethprecompile.StatefulImpl` interface. The view methods are charged
+// a static gas cost on top of the gas of the state that they read.
+func (c *Contract) GasSchedule() ethprecompile.GasSchedule {
+	return ethprecompile.GasSchedule{
+		"getActiveValidators":    {Base: iterationGas},
+		"getValidators":          {Base: iterationGas},
+		"getDelegatorValidators": {Base: iterationGas},
+		"getValidator":           {Base: lookupGas},
+		"getDelegation":          {Base: lookupGas},
+		"getUnbondingDelegation": {Base: lookupGas},
+		"getRedelegations":       {Base: lookupGas},
+	}
+}
+
 // GetActiveValidators implements the `getActiveValidators()` method.
 func (c *Contract) GetActiveValidators(
 	ctx context.Context,
@@ -102,6 +102,15 @@ var _ = Describe("Staking", func() {
 			_, err := sf.Build(contract, nil)
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("should charge the static gas of the view methods", func() {
+			pc, err := sf.Build(contract, nil)
+			Expect(err).ToNot(HaveOccurred())
+			methods := contract.ABIMethods()
+			Expect(pc.RequiredGas(methods["getActiveValidators"].ID)).To(Equal(uint64(iterationGas)))
+			Expect(pc.RequiredGas(methods["getValidator"].ID)).To(Equal(uint64(lookupGas)))
+			Expect(pc.RequiredGas(methods["delegate"].ID)).To(BeZero())
+		})
 	})
 
 	When("ABIEvents", func() {
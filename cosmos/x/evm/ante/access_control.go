@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// ParamsKeeper defines the expected keeper to read the parameters of the x/evm module.
+type ParamsKeeper interface {
+	GetParams(sdk.Context) *types.Params
+}
+
+// AccessControlDecorator rejects the Ethereum transactions whose sender is not allowed to send
+// them by the access control mode of the x/evm module parameters. The contracts created inside
+// the EVM are checked when the transactions are processed.
+type AccessControlDecorator struct {
+	pk ParamsKeeper
+}
+
+// NewAccessControlDecorator returns a new AccessControlDecorator with the given params keeper.
+func NewAccessControlDecorator(pk ParamsKeeper) AccessControlDecorator {
+	return AccessControlDecorator{pk: pk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (acd AccessControlDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	var params *types.Params
+	for _, msg := range tx.GetMsgs() {
+		wrappedTx, ok := msg.(*types.WrappedEthereumTransaction)
+		if !ok {
+			continue
+		}
+		if params == nil {
+			params = acd.pk.GetParams(ctx)
+		}
+
+		ethTx := wrappedTx.AsTransaction()
+		from := coretypes.GetSender(ethTx)
+		switch {
+		case !params.CanSendTransaction(from):
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrUnauthorized, "%s is not allowed to send transactions", from.Hex(),
+			)
+		case ethTx.To() == nil && !params.CanCreateContract(from):
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrUnauthorized, "%s is not allowed to create contracts", from.Hex(),
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"pkg.berachain.dev/polaris/cosmos/testing/types/mock"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAnte(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/x/evm/ante")
+}
+
+// mockParamsKeeper returns the given parameters of the x/evm module.
+type mockParamsKeeper struct {
+	params *types.Params
+}
+
+func (pk *mockParamsKeeper) GetParams(sdk.Context) *types.Params {
+	return pk.params
+}
+
+var _ = Describe("AccessControlDecorator", func() {
+	var (
+		key, _  = crypto.GenerateEthKey()
+		sender  = crypto.PubkeyToAddress(key.PublicKey)
+		signer  = coretypes.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
+		to      = common.HexToAddress("0x2")
+		pk      *mockParamsKeeper
+		acd     AccessControlDecorator
+		called  bool
+		next    sdk.AnteHandler
+		newMsgs func(*common.Address) []sdk.Msg
+	)
+
+	BeforeEach(func() {
+		pk = &mockParamsKeeper{params: types.DefaultParams()}
+		acd = NewAccessControlDecorator(pk)
+		called = false
+		next = func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			called = true
+			return ctx, nil
+		}
+		newMsgs = func(to *common.Address) []sdk.Msg {
+			tx := coretypes.MustSignNewTx(key, signer, &coretypes.DynamicFeeTx{
+				ChainID:   params.DefaultChainConfig.ChainID,
+				Gas:       params.TxGas,
+				GasTipCap: new(big.Int),
+				GasFeeCap: new(big.Int),
+				To:        to,
+				Value:     new(big.Int),
+			})
+			return []sdk.Msg{types.NewFromTransaction(tx)}
+		}
+	})
+
+	anteHandle := func(msgs []sdk.Msg) error {
+		tx := mock.NewTx()
+		tx.GetMsgsFunc = func() []sdk.Msg { return msgs }
+		_, err := acd.AnteHandle(sdk.Context{}, tx, false, next)
+		return err
+	}
+
+	When("the access control mode is open", func() {
+		It("should allow transactions and contract creations", func() {
+			Expect(anteHandle(newMsgs(&to))).To(Succeed())
+			Expect(anteHandle(newMsgs(nil))).To(Succeed())
+			Expect(called).To(BeTrue())
+		})
+	})
+
+	When("the access control mode is the deployer allowlist", func() {
+		BeforeEach(func() {
+			pk.params.AccessControlMode = types.AccessControlMode_ACCESS_CONTROL_MODE_DEPLOYER_ALLOWLIST
+		})
+
+		It("should allow the transactions of any sender", func() {
+			Expect(anteHandle(newMsgs(&to))).To(Succeed())
+			Expect(called).To(BeTrue())
+		})
+
+		It("should reject the contract creations of a sender that is not allowlisted", func() {
+			err := anteHandle(newMsgs(nil))
+			Expect(err).To(MatchError(sdkerrors.ErrUnauthorized))
+			Expect(err.Error()).To(ContainSubstring("not allowed to create contracts"))
+			Expect(called).To(BeFalse())
+		})
+
+		It("should allow the contract creations of an allowlisted sender", func() {
+			pk.params.Allowlist = []string{sender.Hex()}
+			Expect(anteHandle(newMsgs(nil))).To(Succeed())
+			Expect(called).To(BeTrue())
+		})
+	})
+
+	When("the access control mode is the full allowlist", func() {
+		BeforeEach(func() {
+			pk.params.AccessControlMode = types.AccessControlMode_ACCESS_CONTROL_MODE_FULL_ALLOWLIST
+		})
+
+		It("should reject the transactions of a sender that is not allowlisted", func() {
+			err := anteHandle(newMsgs(&to))
+			Expect(err).To(MatchError(sdkerrors.ErrUnauthorized))
+			Expect(err.Error()).To(ContainSubstring("not allowed to send transactions"))
+			Expect(called).To(BeFalse())
+		})
+
+		It("should allow the transactions of an allowlisted sender", func() {
+			pk.params.Allowlist = []string{sender.Hex()}
+			Expect(anteHandle(newMsgs(&to))).To(Succeed())
+			Expect(anteHandle(newMsgs(nil))).To(Succeed())
+			Expect(called).To(BeTrue())
+		})
+	})
+
+	When("the transaction has no Ethereum transactions", func() {
+		It("should call the next handler", func() {
+			pk.params.AccessControlMode = types.AccessControlMode_ACCESS_CONTROL_MODE_FULL_ALLOWLIST
+			Expect(anteHandle([]sdk.Msg{mock.NewMsg()})).To(Succeed())
+			Expect(called).To(BeTrue())
+		})
+	})
+})
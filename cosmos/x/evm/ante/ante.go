@@ -32,8 +32,9 @@ import (
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
+// signer. It also rejects the Ethereum transactions that the access control mode
+// of the x/evm module parameters, read from the given keeper, does not allow.
+func NewAnteHandler(options ante.HandlerOptions, pk ParamsKeeper) (sdk.AnteHandler, error) {
 	if options.AccountKeeper == nil {
 		return nil, errors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
 	}
@@ -46,12 +47,18 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	if pk == nil {
+		return nil, errors.Wrap(sdkerrors.ErrLogic, "params keeper is required for ante builder")
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		// EthTransactions must be allowed by the access control mode of the evm module.
+		NewAccessControlDecorator(pk),
 		// EthTransactions can skip consuming transaction gas as it will be done
 		// in the StateTransition.
 		antelib.NewIgnoreDecorator[ante.ConsumeTxSizeGasDecorator, *types.WrappedEthereumTransaction](
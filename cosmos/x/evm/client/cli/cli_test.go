@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cli_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	cryptocodec "pkg.berachain.dev/polaris/cosmos/crypto/codec"
+	"pkg.berachain.dev/polaris/cosmos/crypto/hd"
+	polarkeyring "pkg.berachain.dev/polaris/cosmos/crypto/keyring"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/accounts"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCLI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/x/evm/client/cli")
+}
+
+// keyName is the name of the eth_secp256k1 key of the test keyring.
+const keyName = "alice"
+
+// mockCometRPC answers the queries of the evm module with the responses set for their gRPC
+// method, and records the broadcast transactions.
+type mockCometRPC struct {
+	clitestutil.MockCometRPC
+	responses map[string]proto.Message
+	broadcast []cmttypes.Tx
+}
+
+func (m *mockCometRPC) ABCIQueryWithOptions(
+	_ context.Context, path string, _ cmtbytes.HexBytes, _ rpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	res, ok := m.responses[path]
+	Expect(ok).To(BeTrue(), "unexpected query %s", path)
+	bz, err := proto.Marshal(res)
+	Expect(err).ToNot(HaveOccurred())
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func (m *mockCometRPC) BroadcastTxSync(
+	_ context.Context, tx cmttypes.Tx,
+) (*coretypes.ResultBroadcastTx, error) {
+	m.broadcast = append(m.broadcast, tx)
+	return &coretypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+// queryPath returns the path of the gRPC method of the query service of the evm module.
+func queryPath(method string) string {
+	return "/polaris.evm.v1alpha1.QueryService/" + method
+}
+
+// newClientCtx returns a client context with the evm module types registered, a keyring holding
+// an eth_secp256k1 key, and the given mock node.
+func newClientCtx(node *mockCometRPC) client.Context {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	cryptocodec.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	kr, err := keyring.New(
+		"evm", keyring.BackendMemory, "", nil, encCfg.Codec, polarkeyring.EthSecp256k1Option(),
+	)
+	Expect(err).ToNot(HaveOccurred())
+	_, _, err = kr.NewMnemonic(
+		keyName, keyring.English, accounts.BIP44HDPath, keyring.DefaultBIP39Passphrase,
+		hd.EthSecp256k1,
+	)
+	Expect(err).ToNot(HaveOccurred())
+
+	return client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig).
+		WithLegacyAmino(encCfg.Amino).
+		WithKeyring(kr).
+		WithClient(node).
+		WithChainID("polaris-2061").
+		WithAccountRetriever(client.MockAccountRetriever{}).
+		WithOutputFormat("json")
+}
@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cli
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// GetQueryCmd returns the root query command of the evm module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the evm module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		AccountCmd(),
+		CodeCmd(),
+		StorageCmd(),
+		BlockCmd(),
+		ReceiptCmd(),
+		ParamsCmd(),
+	)
+
+	return cmd
+}
+
+// ParamsCmd returns the command to query the parameters of the evm module.
+func ParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the evm module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).Params(
+				cmd.Context(), &types.ParamsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AccountCmd returns the command to query the balance, nonce, and code hash of an account.
+func AccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account [address]",
+		Short: "Query the balance, nonce, and code hash of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).Account(
+				cmd.Context(), &types.AccountRequest{Address: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CodeCmd returns the command to query the code of an account.
+func CodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code [address]",
+		Short: "Query the code of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).Code(
+				cmd.Context(), &types.CodeRequest{Address: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(hexutil.Encode(res.Code) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// StorageCmd returns the command to query the value of a storage slot of an account.
+func StorageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage [address] [slot]",
+		Short: "Query the value of a storage slot of an account",
+		Args:  cobra.ExactArgs(2), //nolint:gomnd // address and slot.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).Storage(
+				cmd.Context(), &types.StorageRequest{Address: args[0], Slot: args[1]},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// BlockCmd returns the command to query the header and transactions of the Ethereum block of a
+// number.
+func BlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block [number]",
+		Short: "Query the header and transactions of an Ethereum block",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			number, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryServiceClient(clientCtx).BlockByNumber(
+				cmd.Context(), &types.BlockByNumberRequest{Number: number},
+			)
+			if err != nil {
+				return err
+			}
+
+			block := new(coretypes.Block)
+			if err = rlp.DecodeBytes(res.Block, block); err != nil {
+				return err
+			}
+			bz, err := json.Marshal(struct {
+				Hash         string                 `json:"hash"`
+				Header       *coretypes.Header      `json:"header"`
+				Transactions coretypes.Transactions `json:"transactions"`
+			}{block.Hash().Hex(), block.Header(), block.Transactions()})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ReceiptCmd returns the command to query the Ethereum receipt of a transaction.
+func ReceiptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipt [hash]",
+		Short: "Query the Ethereum receipt of a transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).Receipt(
+				cmd.Context(), &types.ReceiptRequest{Hash: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(res.Receipt)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cli_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/client/cli"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Query Commands", func() {
+	var (
+		node      *mockCometRPC
+		clientCtx client.Context
+		addr      = common.HexToAddress("0x1234")
+	)
+
+	BeforeEach(func() {
+		node = &mockCometRPC{responses: make(map[string]proto.Message)}
+		clientCtx = newClientCtx(node)
+	})
+
+	It("should query an account", func() {
+		node.responses[queryPath("Account")] = &types.AccountResponse{
+			Balance: "100", Nonce: 2, CodeHash: common.Hash{1}.Hex(),
+		}
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.AccountCmd(), []string{addr.Hex()})
+		Expect(err).ToNot(HaveOccurred())
+
+		var res types.AccountResponse
+		Expect(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res)).To(Succeed())
+		Expect(res.Balance).To(Equal("100"))
+		Expect(res.Nonce).To(Equal(uint64(2)))
+		Expect(res.CodeHash).To(Equal(common.Hash{1}.Hex()))
+	})
+
+	It("should query the code of an account as hex", func() {
+		node.responses[queryPath("Code")] = &types.CodeResponse{Code: []byte{1, 2, 3}}
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.CodeCmd(), []string{addr.Hex()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(strings.TrimSpace(out.String())).To(Equal("0x010203"))
+	})
+
+	It("should query a storage slot", func() {
+		value := common.Hash{2}.Hex()
+		node.responses[queryPath("Storage")] = &types.StorageResponse{Value: value}
+		out, err := clitestutil.ExecTestCLICmd(
+			clientCtx, cli.StorageCmd(), []string{addr.Hex(), common.Hash{1}.Hex()},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring(value))
+	})
+
+	It("should query and decode a block", func() {
+		header := &coretypes.Header{Number: big.NewInt(5), GasLimit: 30_000_000}
+		block := coretypes.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+		bz, err := rlp.EncodeToBytes(block)
+		Expect(err).ToNot(HaveOccurred())
+		node.responses[queryPath("BlockByNumber")] = &types.BlockByNumberResponse{Block: bz}
+
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.BlockCmd(), []string{"5"})
+		Expect(err).ToNot(HaveOccurred())
+		var res struct {
+			Hash   string            `json:"hash"`
+			Header *coretypes.Header `json:"header"`
+		}
+		Expect(json.Unmarshal(out.Bytes(), &res)).To(Succeed())
+		Expect(res.Hash).To(Equal(block.Hash().Hex()))
+		Expect(res.Header.Number).To(Equal(big.NewInt(5)))
+	})
+
+	It("should reject an invalid block number", func() {
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.BlockCmd(), []string{"latest"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should query a receipt as json", func() {
+		receipt := []byte(`{"status":"0x1"}`)
+		node.responses[queryPath("Receipt")] = &types.ReceiptResponse{Receipt: receipt}
+		out, err := clitestutil.ExecTestCLICmd(
+			clientCtx, cli.ReceiptCmd(), []string{hexutil.Encode(common.Hash{3}.Bytes())},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.Bytes()).To(MatchJSON(receipt))
+	})
+})
@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"pkg.berachain.dev/polaris/cosmos/crypto/keys/ethsecp256k1"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/params"
+	polarapi "pkg.berachain.dev/polaris/eth/polar/api"
+)
+
+// GetEVMCmd returns the root command of the evm utilities, which are not tx or query commands.
+func GetEVMCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "EVM utility subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		SignCmd(),
+	)
+
+	return cmd
+}
+
+// SignCmd returns the command to sign an Ethereum transaction, given in the JSON format of the
+// `eth_sendTransaction` arguments, with an `eth_secp256k1` key of the keyring. The missing nonce,
+// gas limit, fees, and chain id are queried from the node, unless offline.
+func SignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [tx-json-file]",
+		Short: "Sign an Ethereum transaction with a key of the keyring",
+		Long: `Sign an Ethereum transaction, given in the JSON format of the eth_sendTransaction
+arguments, with the eth_secp256k1 key of the --from flag. The signed, RLP-encoded transaction is
+printed as hex, to be broadcast with the "tx evm send-raw" command. The missing nonce, gas,
+chainId, and fees are queried from the node, unless --offline is set. The fees default to a
+maxPriorityFeePerGas of the suggested tip and a maxFeePerGas of the tip plus twice the base fee,
+or to a gasPrice of the suggested tip if the chain has no base fee.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var txArgs polarapi.TransactionArgs
+			if err = json.Unmarshal(bz, &txArgs); err != nil {
+				return err
+			}
+
+			// The key must be an eth_secp256k1 key to sign Ethereum transactions.
+			record, err := clientCtx.Keyring.Key(clientCtx.FromName)
+			if err != nil {
+				return err
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+			if _, ok := pubKey.(*ethsecp256k1.PubKey); !ok {
+				return fmt.Errorf("key %s is not an eth_secp256k1 key", clientCtx.FromName)
+			}
+			from := common.BytesToAddress(pubKey.Address())
+			if txArgs.From != nil && *txArgs.From != from {
+				return fmt.Errorf("from %s does not match key %s", txArgs.From, clientCtx.FromName)
+			}
+			txArgs.From = &from
+
+			if err = fillTransactionArgs(cmd, clientCtx, &txArgs); err != nil {
+				return err
+			}
+
+			signer := coretypes.LatestSignerForChainID(txArgs.ChainID.ToInt())
+			tx := coretypes.NewTx(newTxData(&txArgs))
+			sig, _, err := clientCtx.Keyring.Sign(
+				clientCtx.FromName, signer.Hash(tx).Bytes(), signing.SignMode_SIGN_MODE_DIRECT,
+			)
+			if err != nil {
+				return err
+			}
+			if tx, err = tx.WithSignature(signer, sig); err != nil {
+				return err
+			}
+
+			if bz, err = tx.MarshalBinary(); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(hexutil.Encode(bz) + "\n")
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// fillTransactionArgs queries the missing nonce, gas limit, fees, and chain id of the given
+// transaction arguments from the node, or returns an error if they are missing while offline.
+func fillTransactionArgs(
+	cmd *cobra.Command, clientCtx client.Context, txArgs *polarapi.TransactionArgs,
+) error {
+	hasFees := txArgs.GasPrice != nil || txArgs.MaxFeePerGas != nil
+	if txArgs.Nonce != nil && txArgs.Gas != nil && txArgs.ChainID != nil && hasFees {
+		return nil
+	}
+	if clientCtx.Offline {
+		return errors.New("nonce, gas, chainId, and gasPrice or maxFeePerGas must be set when offline")
+	}
+	qc := types.NewQueryServiceClient(clientCtx)
+
+	if !hasFees {
+		if err := fillFees(cmd, qc, txArgs); err != nil {
+			return err
+		}
+	}
+
+	if txArgs.Nonce == nil {
+		res, err := qc.Account(cmd.Context(), &types.AccountRequest{Address: txArgs.From.Hex()})
+		if err != nil {
+			return err
+		}
+		txArgs.Nonce = (*hexutil.Uint64)(&res.Nonce)
+	}
+
+	if txArgs.Gas == nil {
+		bz, err := json.Marshal(txArgs)
+		if err != nil {
+			return err
+		}
+		res, err := qc.EstimateGas(cmd.Context(), &types.EstimateGasRequest{Args: bz})
+		if err != nil {
+			return err
+		}
+		txArgs.Gas = (*hexutil.Uint64)(&res.Gas)
+	}
+
+	if txArgs.ChainID == nil {
+		res, err := qc.ChainConfig(cmd.Context(), &types.ChainConfigRequest{})
+		if err != nil {
+			return err
+		}
+		var chainConfig params.ChainConfig
+		if err = json.Unmarshal([]byte(res.Config), &chainConfig); err != nil {
+			return err
+		}
+		txArgs.ChainID = (*hexutil.Big)(chainConfig.ChainID)
+	}
+
+	return nil
+}
+
+// fillFees sets the fees of the given transaction arguments from the fee suggestion of the node.
+// The max fee per gas leaves room for the base fee to double, as in `eth_sendTransaction`.
+func fillFees(
+	cmd *cobra.Command, qc types.QueryServiceClient, txArgs *polarapi.TransactionArgs,
+) error {
+	res, err := qc.FeeSuggestion(cmd.Context(), &types.FeeSuggestionRequest{})
+	if err != nil {
+		return err
+	}
+	tip, ok := new(big.Int).SetString(res.GasTipCap, 10) //nolint:gomnd // base 10.
+	if !ok {
+		return fmt.Errorf("invalid gas tip cap %s", res.GasTipCap)
+	}
+
+	// Without a base fee, the chain only accepts legacy transactions.
+	if res.BaseFee == "" {
+		txArgs.GasPrice = (*hexutil.Big)(tip)
+		return nil
+	}
+	baseFee, ok := new(big.Int).SetString(res.BaseFee, 10) //nolint:gomnd // base 10.
+	if !ok {
+		return fmt.Errorf("invalid base fee %s", res.BaseFee)
+	}
+	if txArgs.MaxPriorityFeePerGas != nil {
+		tip = txArgs.MaxPriorityFeePerGas.ToInt()
+	}
+	txArgs.MaxPriorityFeePerGas = (*hexutil.Big)(tip)
+	txArgs.MaxFeePerGas = (*hexutil.Big)(new(big.Int).Add(tip, baseFee.Lsh(baseFee, 1)))
+	return nil
+}
+
+// newTxData returns the dynamic fee tx data of the given transaction arguments, or the legacy tx
+// data if the max fee per gas is not set.
+func newTxData(txArgs *polarapi.TransactionArgs) coretypes.TxData {
+	var data []byte
+	switch {
+	case txArgs.Input != nil:
+		data = *txArgs.Input
+	case txArgs.Data != nil:
+		data = *txArgs.Data
+	}
+
+	if txArgs.MaxFeePerGas == nil {
+		return &coretypes.LegacyTx{
+			Nonce:    uint64(*txArgs.Nonce),
+			GasPrice: toBig(txArgs.GasPrice),
+			Gas:      uint64(*txArgs.Gas),
+			To:       txArgs.To,
+			Value:    toBig(txArgs.Value),
+			Data:     data,
+		}
+	}
+
+	var accessList coretypes.AccessList
+	if txArgs.AccessList != nil {
+		accessList = *txArgs.AccessList
+	}
+	return &coretypes.DynamicFeeTx{
+		ChainID:    txArgs.ChainID.ToInt(),
+		Nonce:      uint64(*txArgs.Nonce),
+		GasTipCap:  toBig(txArgs.MaxPriorityFeePerGas),
+		GasFeeCap:  toBig(txArgs.MaxFeePerGas),
+		Gas:        uint64(*txArgs.Gas),
+		To:         txArgs.To,
+		Value:      toBig(txArgs.Value),
+		Data:       data,
+		AccessList: accessList,
+	}
+}
+
+// toBig returns the big integer of the given hex big integer, or zero if it is nil.
+func toBig(b *hexutil.Big) *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return b.ToInt()
+}
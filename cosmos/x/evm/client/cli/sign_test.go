@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cli_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/client/cli"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SignCmd", func() {
+	var (
+		node      *mockCometRPC
+		clientCtx client.Context
+		from      common.Address
+		to        = common.HexToAddress("0x1234")
+		chainID   = big.NewInt(2061)
+	)
+
+	BeforeEach(func() {
+		node = &mockCometRPC{responses: make(map[string]proto.Message)}
+		clientCtx = newClientCtx(node)
+
+		record, err := clientCtx.Keyring.Key(keyName)
+		Expect(err).ToNot(HaveOccurred())
+		pubKey, err := record.GetPubKey()
+		Expect(err).ToNot(HaveOccurred())
+		from = common.BytesToAddress(pubKey.Address())
+	})
+
+	// sign writes the given transaction arguments to a file, signs them with the key of the
+	// keyring and returns the decoded signed transaction.
+	sign := func(txArgs map[string]any, extraArgs ...string) (*coretypes.Transaction, error) {
+		bz, err := json.Marshal(txArgs)
+		Expect(err).ToNot(HaveOccurred())
+		file := filepath.Join(GinkgoT().TempDir(), "tx.json")
+		Expect(os.WriteFile(file, bz, 0o600)).To(Succeed())
+
+		out, err := clitestutil.ExecTestCLICmd(
+			clientCtx, cli.SignCmd(),
+			append([]string{file, "--" + flags.FlagFrom, keyName}, extraArgs...),
+		)
+		if err != nil {
+			return nil, err
+		}
+		tx := new(coretypes.Transaction)
+		Expect(tx.UnmarshalBinary(hexutil.MustDecode(strings.TrimSpace(out.String())))).To(Succeed())
+		return tx, nil
+	}
+
+	sender := func(tx *coretypes.Transaction) common.Address {
+		addr, err := coretypes.Sender(coretypes.LatestSignerForChainID(tx.ChainId()), tx)
+		Expect(err).ToNot(HaveOccurred())
+		return addr
+	}
+
+	It("should sign a complete transaction offline", func() {
+		tx, err := sign(map[string]any{
+			"to":                   to,
+			"value":                "0x10",
+			"nonce":                "0x1",
+			"gas":                  "0x5208",
+			"chainId":              hexutil.EncodeBig(chainID),
+			"maxFeePerGas":         "0x64",
+			"maxPriorityFeePerGas": "0x2",
+		}, "--"+flags.FlagOffline)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(sender(tx)).To(Equal(from))
+		Expect(tx.Type()).To(Equal(uint8(coretypes.DynamicFeeTxType)))
+		Expect(*tx.To()).To(Equal(to))
+		Expect(tx.Value()).To(Equal(big.NewInt(16)))
+		Expect(tx.Nonce()).To(Equal(uint64(1)))
+		Expect(tx.Gas()).To(Equal(uint64(21000)))
+		Expect(tx.ChainId()).To(Equal(chainID))
+		Expect(tx.GasFeeCap()).To(Equal(big.NewInt(100)))
+		Expect(tx.GasTipCap()).To(Equal(big.NewInt(2)))
+	})
+
+	It("should fail to sign a transaction without fees offline", func() {
+		_, err := sign(map[string]any{
+			"to":      to,
+			"nonce":   "0x1",
+			"gas":     "0x5208",
+			"chainId": hexutil.EncodeBig(chainID),
+		}, "--"+flags.FlagOffline)
+		Expect(err).To(MatchError(ContainSubstring("must be set when offline")))
+	})
+
+	It("should fail to sign a transaction from another address", func() {
+		_, err := sign(map[string]any{"from": to, "to": to}, "--"+flags.FlagOffline)
+		Expect(err).To(MatchError(ContainSubstring("does not match key")))
+	})
+
+	When("the transaction is incomplete", func() {
+		BeforeEach(func() {
+			config, err := json.Marshal(&params.ChainConfig{ChainID: chainID})
+			Expect(err).ToNot(HaveOccurred())
+			node.responses[queryPath("Account")] = &types.AccountResponse{Nonce: 7}
+			node.responses[queryPath("EstimateGas")] = &types.EstimateGasResponse{Gas: 30000}
+			node.responses[queryPath("ChainConfig")] = &types.ChainConfigResponse{
+				Config: string(config),
+			}
+		})
+
+		It("should fill the nonce, gas, chain id and fees from the node", func() {
+			node.responses[queryPath("FeeSuggestion")] = &types.FeeSuggestionResponse{
+				GasTipCap: "2", BaseFee: "10",
+			}
+
+			tx, err := sign(map[string]any{"to": to})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sender(tx)).To(Equal(from))
+			Expect(tx.Type()).To(Equal(uint8(coretypes.DynamicFeeTxType)))
+			Expect(tx.Nonce()).To(Equal(uint64(7)))
+			Expect(tx.Gas()).To(Equal(uint64(30000)))
+			Expect(tx.ChainId()).To(Equal(chainID))
+			Expect(tx.GasTipCap()).To(Equal(big.NewInt(2)))
+			// The tip plus twice the base fee.
+			Expect(tx.GasFeeCap()).To(Equal(big.NewInt(22)))
+		})
+
+		It("should keep the given tip when filling the max fee", func() {
+			node.responses[queryPath("FeeSuggestion")] = &types.FeeSuggestionResponse{
+				GasTipCap: "2", BaseFee: "10",
+			}
+
+			tx, err := sign(map[string]any{"to": to, "maxPriorityFeePerGas": "0x5"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tx.GasTipCap()).To(Equal(big.NewInt(5)))
+			Expect(tx.GasFeeCap()).To(Equal(big.NewInt(25)))
+		})
+
+		It("should sign a legacy transaction if the chain has no base fee", func() {
+			node.responses[queryPath("FeeSuggestion")] = &types.FeeSuggestionResponse{
+				GasTipCap: "3",
+			}
+
+			tx, err := sign(map[string]any{"to": to})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sender(tx)).To(Equal(from))
+			Expect(tx.Type()).To(Equal(uint8(coretypes.LegacyTxType)))
+			Expect(tx.GasPrice()).To(Equal(big.NewInt(3)))
+		})
+	})
+})
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cli_test
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/client/cli"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SendRawCmd", func() {
+	var (
+		node      *mockCometRPC
+		clientCtx client.Context
+	)
+
+	BeforeEach(func() {
+		node = &mockCometRPC{}
+		clientCtx = newClientCtx(node)
+	})
+
+	It("should broadcast the transaction wrapped in a cosmos tx", func() {
+		key, err := crypto.GenerateEthKey()
+		Expect(err).ToNot(HaveOccurred())
+		signer := coretypes.LatestSignerForChainID(big.NewInt(2061))
+		tx := coretypes.MustSignNewTx(key, signer, &coretypes.DynamicFeeTx{
+			ChainID:   big.NewInt(2061),
+			Nonce:     3,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(10),
+			Gas:       21000,
+			To:        &common.Address{1},
+			Value:     big.NewInt(5),
+		})
+		bz, err := tx.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.SendRawCmd(), []string{hexutil.Encode(bz)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.broadcast).To(HaveLen(1))
+
+		sdkTx, err := clientCtx.TxConfig.TxDecoder()(node.broadcast[0])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdkTx.GetMsgs()).To(HaveLen(1))
+		wrapped, ok := sdkTx.GetMsgs()[0].(*types.WrappedEthereumTransaction)
+		Expect(ok).To(BeTrue())
+		Expect(wrapped.AsTransaction().Hash()).To(Equal(tx.Hash()))
+	})
+
+	It("should reject an invalid transaction", func() {
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.SendRawCmd(), []string{"0x1234"})
+		Expect(err).To(HaveOccurred())
+		Expect(node.broadcast).To(BeEmpty())
+	})
+})
@@ -26,6 +26,8 @@ import (
 	store "cosmossdk.io/store/types"
 
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	modulev1alpha1 "pkg.berachain.dev/polaris/cosmos/api/polaris/evm/module/v1alpha1"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
@@ -69,12 +71,19 @@ func ProvideModule(in DepInjectInput) DepInjectOutput {
 		in.CustomPrecompiles = func() *ethprecompile.Injector { return &ethprecompile.Injector{} }
 	}
 
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
 	k := keeper.NewKeeper(
 		in.AccountKeeper,
 		in.StakingKeeper,
 		in.Key,
 		in.Mempool,
 		in.CustomPrecompiles,
+		authority,
 	)
 
 	m := NewAppModule(k, in.AccountKeeper)
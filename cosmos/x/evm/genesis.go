@@ -29,34 +29,37 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 )
 
 // DefaultGenesis returns default genesis state as raw bytes for the evm
 // module.
 func (AppModuleBasic) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
-	ethGen, err := core.DefaultGenesis.MarshalJSON()
+	genState, err := types.DefaultGenesisState().MarshalJSON()
 	if err != nil {
 		panic(err)
 	}
-	return ethGen
+	return genState
 }
 
 // ValidateGenesis performs genesis state validation for the evm module.
 func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
-	ethGen := new(core.Genesis)
-	return ethGen.UnmarshalJSON(bz) // todo: improve
+	genState := new(types.GenesisState)
+	if err := genState.UnmarshalJSON(bz); err != nil {
+		return err
+	}
+	return genState.ValidateBasic()
 }
 
 // InitGenesis performs genesis initialization for the evm module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var ethGen core.Genesis
-	if err := ethGen.UnmarshalJSON(data); err != nil {
+	var genState types.GenesisState
+	if err := genState.UnmarshalJSON(data); err != nil {
 		panic(err)
 	}
 
-	if err := am.keeper.InitGenesis(ctx, &ethGen); err != nil {
+	if err := am.keeper.InitGenesis(ctx, &genState); err != nil {
 		panic(err)
 	}
 	return []abci.ValidatorUpdate{}
@@ -65,10 +68,10 @@ func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, data json.Ra
 // ExportGenesis returns the exported genesis state as raw bytes for the evm
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
-	ethGen := am.keeper.ExportGenesis(ctx)
-	ethGenBz, err := ethGen.MarshalJSON()
+	genState := am.keeper.ExportGenesis(ctx)
+	genStateBz, err := genState.MarshalJSON()
 	if err != nil {
 		panic(err)
 	}
-	return ethGenBz
+	return genStateBz
 }
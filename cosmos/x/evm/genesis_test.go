@@ -25,11 +25,15 @@ import (
 	"encoding/json"
 	"fmt"
 
+	dbm "github.com/cosmos/cosmos-db"
+
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	"pkg.berachain.dev/polaris/cosmos/precompile/staking"
@@ -38,6 +42,7 @@ import (
 	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
 	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/core"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
 
@@ -70,8 +75,9 @@ var _ = Describe("", func() {
 			func() *ethprecompile.Injector {
 				return ethprecompile.NewPrecompiles([]ethprecompile.Registrable{sc}...)
 			},
+			authtypes.NewModuleAddress(govtypes.ModuleName),
 		)
-		k.Setup(storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger())
+		k.Setup(dbm.NewMemDB(), nil, nil, "", GinkgoT().TempDir(), log.NewNopLogger())
 
 		am = evm.NewAppModule(k, ak)
 	})
@@ -145,6 +151,86 @@ var _ = Describe("", func() {
 		})
 	})
 
+	Context("On the params of the genesis", func() {
+		var params *types.Params
+
+		BeforeEach(func() {
+			params = &types.Params{
+				AccessControlMode: types.AccessControlMode_ACCESS_CONTROL_MODE_DEPLOYER_ALLOWLIST,
+				Allowlist:         []string{"0x0000000000000000000000000000000000000001"},
+			}
+		})
+
+		It("should default the params of an Ethereum genesis", func() {
+			bz, err := json.Marshal(ethGen)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(am.ValidateGenesis(cdc, nil, bz)).To(Succeed())
+			am.InitGenesis(ctx, cdc, bz)
+			Expect(k.GetParams(ctx)).To(Equal(types.DefaultParams()))
+		})
+
+		It("should init and export the params", func() {
+			bz, err := json.Marshal(&types.GenesisState{Ethereum: ethGen, Params: params})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(am.ValidateGenesis(cdc, nil, bz)).To(Succeed())
+			am.InitGenesis(ctx, cdc, bz)
+			Expect(k.GetParams(ctx)).To(Equal(params))
+
+			exported := new(types.GenesisState)
+			Expect(exported.UnmarshalJSON(am.ExportGenesis(ctx, cdc))).To(Succeed())
+			Expect(exported.Params).To(Equal(params))
+		})
+
+		It("should reject invalid params", func() {
+			params.Allowlist = []string{"invalid"}
+			bz, err := json.Marshal(&types.GenesisState{Ethereum: ethGen, Params: params})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(am.ValidateGenesis(cdc, nil, bz)).ToNot(Succeed())
+		})
+	})
+
+	Context("On the dynamic precompiles of the genesis", func() {
+		var dps []*types.DynamicPrecompile
+
+		BeforeEach(func() {
+			dps = []*types.DynamicPrecompile{
+				{
+					Name:               "dynamic",
+					Address:            "0x0000000000000000000000000000000000000069",
+					ActivationHeight:   10,
+					DeactivationHeight: 20,
+				},
+				{
+					Name:             "dynamic",
+					Address:          "0x0000000000000000000000000000000000000420",
+					ActivationHeight: 30,
+				},
+			}
+		})
+
+		It("should init and export the dynamic precompiles", func() {
+			bz, err := json.Marshal(&types.GenesisState{
+				Ethereum: ethGen, Params: types.DefaultParams(), DynamicPrecompiles: dps,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(am.ValidateGenesis(cdc, nil, bz)).To(Succeed())
+			am.InitGenesis(ctx, cdc, bz)
+
+			exported := new(types.GenesisState)
+			Expect(exported.UnmarshalJSON(am.ExportGenesis(ctx, cdc))).To(Succeed())
+			Expect(exported.DynamicPrecompiles).To(Equal(dps))
+		})
+
+		It("should reject duplicate addresses", func() {
+			dps[1].Address = dps[0].Address
+			bz, err := json.Marshal(&types.GenesisState{
+				Ethereum: ethGen, Params: types.DefaultParams(), DynamicPrecompiles: dps,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(am.ValidateGenesis(cdc, nil, bz)).ToNot(Succeed())
+		})
+	})
+
 	Context("On ExportGenesis", func() {
 		var (
 			actualGenesis core.Genesis
@@ -24,6 +24,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/block"
 )
 
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
@@ -31,10 +33,16 @@ func (k *Keeper) BeginBlocker(ctx context.Context) error {
 	// Prepare the Polaris Ethereum block.
 	k.lock = false
 	k.polaris.Prepare(ctx, uint64(sCtx.BlockHeight()))
+
+	// Record the events emitted by the BeginBlock of the preceding modules.
+	k.host.GetBlockPlugin().(block.Plugin).RecordEvents(sCtx.EventManager().Events())
 	return nil
 }
 
 func (k *Keeper) EndBlock(ctx context.Context) error {
+	// Record the events emitted by the EndBlock of the preceding modules.
+	k.host.GetBlockPlugin().(block.Plugin).RecordEvents(sdk.UnwrapSDKContext(ctx).EventManager().Events())
+
 	// Finalize the Polaris Ethereum block.
 	return k.polaris.Finalize(ctx)
 }
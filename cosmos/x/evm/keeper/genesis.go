@@ -24,28 +24,35 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/core"
 	"pkg.berachain.dev/polaris/lib/utils"
 )
 
 // InitGenesis is called during the InitGenesis.
-func (k *Keeper) InitGenesis(ctx sdk.Context, genState *core.Genesis) error {
+func (k *Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) error {
 	// Initialize all the plugins.
 	for _, plugin := range k.host.GetAllPlugins() {
 		// checks whether plugin implements methods of HasGenesis and executes them if it does
 		if plugin, ok := utils.GetAs[plugins.HasGenesis](plugin); ok {
-			plugin.InitGenesis(ctx, genState)
+			plugin.InitGenesis(ctx, genState.Ethereum)
+		}
+		if plugin, ok := utils.GetAs[plugins.HasModuleGenesis](plugin); ok {
+			plugin.InitModuleGenesis(ctx, genState)
 		}
 	}
 	return nil
 }
 
 // ExportGenesis returns the exported genesis state.
-func (k *Keeper) ExportGenesis(ctx sdk.Context) *core.Genesis {
-	genesisState := new(core.Genesis)
+func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genesisState := &types.GenesisState{Ethereum: new(core.Genesis)}
 	for _, plugin := range k.host.GetAllPlugins() {
 		if plugin, ok := utils.GetAs[plugins.HasGenesis](plugin); ok {
-			plugin.ExportGenesis(ctx, genesisState)
+			plugin.ExportGenesis(ctx, genesisState.Ethereum)
+		}
+		if plugin, ok := utils.GetAs[plugins.HasModuleGenesis](plugin); ok {
+			plugin.ExportModuleGenesis(ctx, genesisState)
 		}
 	}
 	return genesisState
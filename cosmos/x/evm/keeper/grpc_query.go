@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/configuration"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/polar"
+	polarapi "pkg.berachain.dev/polaris/eth/polar/api"
+	"pkg.berachain.dev/polaris/eth/rpc"
+)
+
+// Compile-time interface assertion.
+var _ types.QueryServiceServer = (*Keeper)(nil)
+
+// Account queries the balance, nonce, and code hash of an account.
+func (k *Keeper) Account(
+	ctx context.Context, req *types.AccountRequest,
+) (*types.AccountResponse, error) {
+	addr, err := hexAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sp := k.newStatePlugin(sdk.UnwrapSDKContext(ctx))
+	return &types.AccountResponse{
+		Balance:  sp.GetBalance(addr).String(),
+		Nonce:    sp.GetNonce(addr),
+		CodeHash: sp.GetCodeHash(addr).Hex(),
+	}, nil
+}
+
+// Code queries the code of an account.
+func (k *Keeper) Code(ctx context.Context, req *types.CodeRequest) (*types.CodeResponse, error) {
+	addr, err := hexAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CodeResponse{
+		Code: k.newStatePlugin(sdk.UnwrapSDKContext(ctx)).GetCode(addr),
+	}, nil
+}
+
+// Storage queries the value of a storage slot of an account.
+func (k *Keeper) Storage(
+	ctx context.Context, req *types.StorageRequest,
+) (*types.StorageResponse, error) {
+	addr, err := hexAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	value := k.newStatePlugin(sdk.UnwrapSDKContext(ctx)).GetState(addr, common.HexToHash(req.Slot))
+	return &types.StorageResponse{Value: value.Hex()}, nil
+}
+
+// EthCall executes a message call without creating a transaction, on the state at the height of
+// the query context.
+func (k *Keeper) EthCall(
+	ctx context.Context, req *types.EthCallRequest,
+) (*types.EthCallResponse, error) {
+	var args polarapi.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	backend, err := k.backend()
+	if err != nil {
+		return nil, err
+	}
+	ret, err := polarapi.NewBlockChainAPI(backend).Call(
+		ctx, args, queryBlockNumber(ctx), nil, nil,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.EthCallResponse{Ret: ret}, nil
+}
+
+// EstimateGas estimates the gas needed to execute a transaction, on the state at the height of the
+// query context.
+func (k *Keeper) EstimateGas(
+	ctx context.Context, req *types.EstimateGasRequest,
+) (*types.EstimateGasResponse, error) {
+	var args polarapi.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	backend, err := k.backend()
+	if err != nil {
+		return nil, err
+	}
+	blockNumber := queryBlockNumber(ctx)
+	gas, err := polarapi.NewBlockChainAPI(backend).EstimateGas(ctx, args, &blockNumber)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.EstimateGasResponse{Gas: uint64(gas)}, nil
+}
+
+// FeeSuggestion queries the suggested gas tip cap and the base fee of the block following the
+// latest block.
+func (k *Keeper) FeeSuggestion(
+	ctx context.Context, _ *types.FeeSuggestionRequest,
+) (*types.FeeSuggestionResponse, error) {
+	backend, err := k.backend()
+	if err != nil {
+		return nil, err
+	}
+
+	tip, err := backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &types.FeeSuggestionResponse{GasTipCap: tip.String()}
+
+	head, cfg := backend.CurrentHeader(), backend.ChainConfig()
+	if head != nil && cfg.IsLondon(new(big.Int).Add(head.Number, common.Big1)) {
+		resp.BaseFee = misc.CalcBaseFee(cfg, head).String()
+	}
+	return resp, nil
+}
+
+// ChainConfig queries the Ethereum chain config.
+func (k *Keeper) ChainConfig(
+	ctx context.Context, _ *types.ChainConfigRequest,
+) (*types.ChainConfigResponse, error) {
+	cp := configuration.NewPlugin(k.storeKey)
+	cp.Prepare(ctx)
+
+	bz, err := json.Marshal(cp.ChainConfig())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.ChainConfigResponse{Config: string(bz)}, nil
+}
+
+// Params queries the parameters of the x/evm module.
+func (k *Keeper) Params(
+	ctx context.Context, _ *types.ParamsRequest,
+) (*types.ParamsResponse, error) {
+	return &types.ParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// BlockByNumber queries the Ethereum block of a number.
+func (k *Keeper) BlockByNumber(
+	ctx context.Context, req *types.BlockByNumberRequest,
+) (*types.BlockByNumberResponse, error) {
+	backend, err := k.backend()
+	if err != nil {
+		return nil, err
+	}
+	block, err := backend.BlockByNumber(ctx, rpc.BlockNumber(req.Number))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if block == nil {
+		return nil, status.Errorf(codes.NotFound, "block %d not found", req.Number)
+	}
+
+	bz, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.BlockByNumberResponse{Block: bz}, nil
+}
+
+// Receipt queries the Ethereum receipt of a transaction.
+func (k *Keeper) Receipt(
+	ctx context.Context, req *types.ReceiptRequest,
+) (*types.ReceiptResponse, error) {
+	backend, err := k.backend()
+	if err != nil {
+		return nil, err
+	}
+	tx, blockHash, _, index, err := backend.GetTransaction(ctx, common.HexToHash(req.Hash))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if tx == nil {
+		return nil, status.Errorf(codes.NotFound, "transaction %s not found", req.Hash)
+	}
+
+	receipts, err := backend.GetReceipts(ctx, blockHash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if uint64(len(receipts)) <= index {
+		return nil, status.Errorf(codes.NotFound, "receipt of transaction %s not found", req.Hash)
+	}
+
+	bz, err := json.Marshal(receipts[index])
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.ReceiptResponse{Receipt: bz}, nil
+}
+
+// backend returns the backend of the Polaris EVM, or an error if the keeper has not been set up
+// yet.
+func (k *Keeper) backend() (polar.Backend, error) {
+	if k.polaris == nil {
+		return nil, status.Error(codes.Unavailable, "polaris evm is not set up")
+	}
+	return k.polaris.Backend(), nil
+}
+
+// hexAddress returns the address of the given hex string, or an error if it is not a valid
+// address.
+func hexAddress(address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, status.Errorf(codes.InvalidArgument, "invalid address %s", address)
+	}
+	return common.HexToAddress(address), nil
+}
+
+// queryBlockNumber returns the block number of the height of the given query context.
+func queryBlockNumber(ctx context.Context) rpc.BlockNumberOrHash {
+	return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(sdk.UnwrapSDKContext(ctx).BlockHeight()))
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/params"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GRPC Query Server", func() {
+	var (
+		k    *keeper.Keeper
+		qs   types.QueryServiceServer
+		ctx  sdk.Context
+		addr = common.HexToAddress("0x1234")
+		slot = common.HexToHash("0x01")
+		code = []byte("code")
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		var sk stakingkeeper.Keeper
+		ctx, ak, _, sk = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			ak, sk,
+			storetypes.NewKVStoreKey("evm"),
+			evmmempool.NewPolarisEthereumTxPool(),
+			func() *ethprecompile.Injector {
+				return ethprecompile.NewPrecompiles()
+			},
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+		)
+		k.Setup(dbm.NewMemDB(), nil, nil, "", GinkgoT().TempDir(), log.NewNopLogger())
+		qs = k
+
+		ctx = ctx.WithBlockHeight(0)
+		genesis := *core.DefaultGenesis
+		genesis.Alloc = core.GenesisAlloc{
+			addr: {
+				Balance: big.NewInt(100),
+				Nonce:   2,
+				Code:    code,
+				Storage: map[common.Hash]common.Hash{slot: common.HexToHash("0x02")},
+			},
+		}
+		for _, plugin := range k.GetHost().GetAllPlugins() {
+			if plugin, ok := utils.GetAs[plugins.HasGenesis](plugin); ok {
+				plugin.InitGenesis(ctx, &genesis)
+			}
+		}
+	})
+
+	It("should reject invalid addresses", func() {
+		_, err := qs.Account(ctx, &types.AccountRequest{Address: "invalid"})
+		Expect(err).To(HaveOccurred())
+		_, err = qs.Code(ctx, &types.CodeRequest{})
+		Expect(err).To(HaveOccurred())
+		_, err = qs.Storage(ctx, &types.StorageRequest{Address: "0x12", Slot: slot.Hex()})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should query the account", func() {
+		resp, err := qs.Account(ctx, &types.AccountRequest{Address: addr.Hex()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Balance).To(Equal("100"))
+		Expect(resp.Nonce).To(Equal(uint64(2)))
+		Expect(resp.CodeHash).To(Equal(crypto.Keccak256Hash(code).Hex()))
+	})
+
+	It("should query the code and storage", func() {
+		codeResp, err := qs.Code(ctx, &types.CodeRequest{Address: addr.Hex()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(codeResp.Code).To(Equal(code))
+
+		storageResp, err := qs.Storage(ctx, &types.StorageRequest{
+			Address: addr.Hex(), Slot: slot.Hex(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(storageResp.Value).To(Equal(common.HexToHash("0x02").Hex()))
+	})
+
+	It("should be unavailable before the keeper is set up", func() {
+		qs = keeper.NewKeeper(
+			nil, nil,
+			storetypes.NewKVStoreKey("evm"),
+			evmmempool.NewPolarisEthereumTxPool(),
+			func() *ethprecompile.Injector {
+				return ethprecompile.NewPrecompiles()
+			},
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+		)
+		unavailable := func(_ any, err error) {
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		}
+		unavailable(qs.EthCall(ctx, &types.EthCallRequest{Args: []byte("{}")}))
+		unavailable(qs.EstimateGas(ctx, &types.EstimateGasRequest{Args: []byte("{}")}))
+		unavailable(qs.FeeSuggestion(ctx, &types.FeeSuggestionRequest{}))
+		unavailable(qs.BlockByNumber(ctx, &types.BlockByNumberRequest{}))
+		unavailable(qs.Receipt(ctx, &types.ReceiptRequest{Hash: common.Hash{}.Hex()}))
+	})
+
+	It("should suggest the fees", func() {
+		resp, err := qs.FeeSuggestion(ctx, &types.FeeSuggestionRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.GasTipCap).ToNot(BeEmpty())
+	})
+
+	It("should query the chain config", func() {
+		resp, err := qs.ChainConfig(ctx, &types.ChainConfigRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		var config params.ChainConfig
+		Expect(json.Unmarshal([]byte(resp.Config), &config)).To(Succeed())
+		Expect(config.ChainID).To(Equal(core.DefaultGenesis.Config.ChainID))
+	})
+})
@@ -21,6 +21,12 @@
 package keeper
 
 import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -51,9 +57,10 @@ type Host interface {
 	GetAllPlugins() []plugins.Base
 	Setup(
 		storetypes.StoreKey,
-		storetypes.StoreKey,
+		dbm.DB,
 		state.AccountKeeper,
 		func(height int64, prove bool) (sdk.Context, error),
+		func(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error),
 	)
 }
 
@@ -84,6 +91,8 @@ func NewHost(
 	h.bp = block.NewPlugin(storeKey, sk)
 	h.cp = configuration.NewPlugin(storeKey)
 	h.gp = gas.NewPlugin()
+	// TODO: re-enable historical plugin using ABCI listener.
+	h.hp = historical.NewPlugin(h.cp, h.bp, storeKey)
 	h.txp = txpool.NewPlugin(utils.MustGetAs[*mempool.EthTxPool](ethTxMempool))
 	h.pcs = precompiles
 
@@ -91,23 +100,36 @@ func NewHost(
 }
 
 // Setup sets up the precompile and state plugins with the given precompiles and keepers. It also
-// sets the query context function for the block and state plugins (to support historical queries).
+// sets the log factory of the block plugin, the query context function for the block and state
+// plugins (to support historical queries),
+// the ABCI query function for the state plugin (to support proofs of state), and the off-chain
+// database of the historical plugin (which falls back to the consensus store if nil).
 func (h *host) Setup(
 	storeKey storetypes.StoreKey,
-	_ storetypes.StoreKey,
+	historicalDB dbm.DB,
 	ak state.AccountKeeper,
 	qc func(height int64, prove bool) (sdk.Context, error),
+	qp func(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error),
 ) {
-	// Setup the state, precompile, historical, and txpool plugins
-	h.sp = state.NewPlugin(ak, storeKey, log.NewFactory(h.pcs().GetPrecompiles()))
-	h.pp = precompile.NewPlugin(h.pcs().GetPrecompiles(), h.sp)
-	// TODO: re-enable historical plugin using ABCI listener.
-	h.hp = historical.NewPlugin(h.cp, h.bp, nil, storeKey)
+	// Setup the state, precompile, and txpool plugins
+	plf := log.NewFactory(h.pcs().GetPrecompiles())
+	h.sp = state.NewPlugin(ak, storeKey, plf)
+	h.pp = precompile.NewPlugin(h.pcs().GetPrecompiles(), h.sp, storeKey)
+	h.pp.SetLogFactory(plf)
 	h.txp.SetNonceRetriever(h.sp)
 
+	// Set the log factory used to build Eth logs from the Cosmos events recorded by the block plugin
+	h.bp.SetLogFactory(plf)
+
 	// Set the query context function for the block and state plugins
 	h.sp.SetQueryContextFn(qc)
 	h.bp.SetQueryContextFn(qc)
+
+	// Set the ABCI query function for the state plugin
+	h.sp.SetABCIQueryFn(qp)
+
+	// Set the off-chain database for the historical plugin
+	h.hp.SetOffChainDB(historicalDB)
 }
 
 // GetBlockPlugin returns the header plugin.
@@ -21,9 +21,16 @@
 package keeper
 
 import (
+	"context"
+	"errors"
 	"math/big"
+	"path/filepath"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -33,6 +40,8 @@ import (
 
 	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/block"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/configuration"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
@@ -50,6 +59,17 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 	// The host contains various plugins that are are used to implement `core.PolarisHostChain`.
 	host Host
+	// authority is the address that is allowed to update the chain config, usually the gov module.
+	authority sdk.AccAddress
+
+	// bk, denom, and decimals configure the bank denom backing the EVM native balance, if bk is
+	// set. They are set in `SetBankBalances`.
+	bk       state.BankKeeper
+	denom    string
+	decimals uint8
+
+	// logger is the logger of the module, it is set in `Setup`.
+	logger log.Logger
 
 	// temp syncing
 	lock bool
@@ -62,12 +82,15 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	ethTxMempool sdkmempool.Mempool,
 	pcs func() *ethprecompile.Injector,
+	authority sdk.AccAddress,
 ) *Keeper {
 	// We setup the keeper with some Cosmos standard sauce.
 	k := &Keeper{
-		ak:       ak,
-		storeKey: storeKey,
-		lock:     true,
+		ak:        ak,
+		storeKey:  storeKey,
+		authority: authority,
+		lock:      true,
+		logger:    log.NewNopLogger(),
 	}
 
 	k.host = NewHost(
@@ -79,16 +102,21 @@ func NewKeeper(
 	return k
 }
 
-// Setup sets up the plugins in the Host. It also build the Polaris EVM Provider.
+// Setup sets up the plugins in the Host. It also build the Polaris EVM Provider. The historical
+// blocks, receipts, and transactions are stored in the given node-local database, or in the
+// consensus store if it is nil.
 func (k *Keeper) Setup(
-	_ *storetypes.KVStoreKey,
+	historicalDB dbm.DB,
 	qc func(height int64, prove bool) (sdk.Context, error),
+	qp func(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error),
 	polarisConfigPath string,
 	polarisDataDir string,
 	logger log.Logger,
 ) {
+	k.logger = logger
+
 	// Setup plugins in the Host
-	k.host.Setup(k.storeKey, nil, k.ak, qc)
+	k.host.Setup(k.storeKey, historicalDB, k.ak, qc, qp)
 
 	// Build the Polaris EVM Provider
 	cfg, err := polar.LoadConfigFromFilePath(polarisConfigPath)
@@ -99,6 +127,27 @@ func (k *Keeper) Setup(
 		cfg = polar.DefaultConfig()
 	}
 
+	// Enforce the retention policy of the historical data, which is rejected if the historical
+	// data is stored in the consensus store.
+	if err = k.SetHistoricalRetentionPolicy(historical.RetentionPolicy{
+		Blocks:     cfg.History.Blocks,
+		Age:        cfg.History.Age,
+		Tombstones: cfg.History.Tombstones,
+	}); err != nil {
+		panic(err)
+	}
+
+	// Configure the limits of the txpool and journal its local transactions, unless disabled.
+	txp := k.host.GetTxPoolPlugin().(txpool.Plugin)
+	txp.SetConfig(cfg.TxPool)
+	if cfg.TxPool.Journal != "" && !cfg.TxPool.NoLocals {
+		journal := cfg.TxPool.Journal
+		if !filepath.IsAbs(journal) {
+			journal = filepath.Join(polarisDataDir, journal)
+		}
+		txp.SetJournal(journal, cfg.TxPool.Rejournal, logger)
+	}
+
 	// TODO: PARSE POLARIS.TOML CORRECT AGAIN
 	nodeCfg := polar.DefaultGethNodeConfig()
 	nodeCfg.DataDir = polarisDataDir
@@ -128,11 +177,48 @@ func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With(types.ModuleName)
 }
 
+// GetParams returns the parameters of the x/evm module.
+func (k *Keeper) GetParams(ctx sdk.Context) *types.Params {
+	cp := configuration.NewPlugin(k.storeKey)
+	cp.Prepare(ctx)
+	return cp.Params()
+}
+
 // GetHost returns the Host that contains all plugins.
 func (k *Keeper) GetHost() Host {
 	return k.host
 }
 
+// SetHistoricalRetentionPolicy sets the retention policy of the historical blocks, receipts, and
+// transactions. As it is node-local config, it returns an error if the policy prunes any history
+// while they are stored in the consensus store.
+func (k *Keeper) SetHistoricalRetentionPolicy(rp historical.RetentionPolicy) error {
+	return k.host.GetHistoricalPlugin().(historical.Plugin).SetRetentionPolicy(rp)
+}
+
+// SetCosmosEventLogs sets whether the registered Cosmos events emitted outside of the EVM, during
+// the BeginBlock and EndBlock of the modules that precede the evm module, are attached to the
+// Polaris block as the logs of a synthetic receipt. As this changes the blocks, it must be the
+// same on every node of the network.
+//
+// NOTE: the events of the messages of the Cosmos txs are not attached, as they are emitted on
+// message-scoped event managers and only returned in the tx results, once the Polaris block has
+// been finalized in the EndBlock of the evm module.
+func (k *Keeper) SetCosmosEventLogs(enabled bool) {
+	k.host.GetBlockPlugin().(block.Plugin).SetCosmosEventLogs(enabled)
+}
+
+// SetBankBalances backs the EVM native balance by the given bank denom, which has the given number
+// of decimals, instead of the balance store of the evm module. The evm module account must have
+// the minter and burner permissions. It must be called after `Setup` and before the genesis is
+// initialized, and it must be the same on every node of the network.
+func (k *Keeper) SetBankBalances(bk state.BankKeeper, denom string, decimals uint8) {
+	k.host.GetStatePlugin().(state.Plugin).SetBankBalances(bk, denom, decimals)
+	k.bk = bk
+	k.denom = denom
+	k.decimals = decimals
+}
+
 func (k *Keeper) SetClientCtx(clientContext client.Context) {
 	k.host.GetTxPoolPlugin().(txpool.Plugin).SetClientContext(clientContext)
 	// TODO: move this
@@ -142,36 +228,70 @@ func (k *Keeper) SetClientCtx(clientContext client.Context) {
 			continue
 		}
 
+		// Re-inject the journaled local transactions into the txpool.
+		k.host.GetTxPoolPlugin().(txpool.Plugin).StartJournal()
+
+		// Report the syncing and p2p status of the CometBFT node over JSON-RPC.
+		k.polaris.SetStatusProvider(newStatusProvider(clientContext, k.logger))
+
 		if err := k.polaris.StartServices(); err != nil {
 			panic(err)
 		}
 	}()
 }
 
+// Close stops the background services of the Polaris EVM and closes the txpool journal, it is
+// called when the app shuts down.
+func (k *Keeper) Close() error {
+	if k.polaris == nil {
+		return nil
+	}
+	return errors.Join(
+		k.polaris.StopServices(), k.host.GetTxPoolPlugin().(txpool.Plugin).StopJournal(),
+	)
+}
+
 // TODO: Remove these, because they're hacky af.
 // Required temporarily for BGT plugin.
 func (k *Keeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress) *big.Int {
-	ethAddr := cosmlib.AccAddressToEthAddress(addr)
-	return new(big.Int).SetBytes(ctx.KVStore(k.storeKey).Get(state.BalanceKeyFor(ethAddr)))
+	return k.newStatePlugin(ctx).GetBalance(cosmlib.AccAddressToEthAddress(addr))
+}
+
+func (k *Keeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) error {
+	sp := k.newStatePlugin(ctx)
+	sp.SetBalance(cosmlib.AccAddressToEthAddress(addr), amount)
+	return k.finalizeBalancePlugin(sp)
+}
+
+func (k *Keeper) AddBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) error {
+	sp := k.newStatePlugin(ctx)
+	sp.AddBalance(cosmlib.AccAddressToEthAddress(addr), amount)
+	return k.finalizeBalancePlugin(sp)
 }
 
-func (k *Keeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) {
-	ethAddr := cosmlib.AccAddressToEthAddress(addr)
-	ctx.KVStore(k.storeKey).Set(state.BalanceKeyFor(ethAddr), amount.Bytes())
+func (k *Keeper) SubBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) error {
+	sp := k.newStatePlugin(ctx)
+	sp.SubBalance(cosmlib.AccAddressToEthAddress(addr), amount)
+	return k.finalizeBalancePlugin(sp)
 }
 
-func (k *Keeper) AddBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) {
-	if amount.Sign() == 0 {
-		return
+// newStatePlugin returns a state plugin on the given context, configured like the state plugin of
+// the host, to read and write the EVM state outside of the EVM.
+func (k *Keeper) newStatePlugin(ctx sdk.Context) state.Plugin {
+	sp := state.NewPlugin(k.ak, k.storeKey, nil)
+	if k.bk != nil {
+		sp.SetBankBalances(k.bk, k.denom, k.decimals)
 	}
-	ethAddr := cosmlib.AccAddressToEthAddress(addr)
-	ctx.KVStore(k.storeKey).Set(state.BalanceKeyFor(ethAddr), new(big.Int).Add(k.GetBalance(ctx, addr), amount).Bytes())
+	sp.Reset(ctx)
+	return sp
 }
 
-func (k *Keeper) SubBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) {
-	if amount.Sign() == 0 {
-		return
+// finalizeBalancePlugin writes the balance changes of the given state plugin to its context, unless
+// they failed.
+func (k *Keeper) finalizeBalancePlugin(sp state.Plugin) error {
+	if err := sp.Error(); err != nil {
+		return err
 	}
-	ethAddr := cosmlib.AccAddressToEthAddress(addr)
-	ctx.KVStore(k.storeKey).Set(state.BalanceKeyFor(ethAddr), new(big.Int).Sub(k.GetBalance(ctx, addr), amount).Bytes())
+	sp.Finalize()
+	return nil
 }
@@ -22,10 +22,20 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/configuration"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/precompile"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/params"
 )
 
 // Compile-time check to ensure `Keeper` implements the `MsgServiceServer` interface.
@@ -54,3 +64,122 @@ func (k *Keeper) EthTransaction(
 		ReturnData: result.ReturnData,
 	}, nil
 }
+
+// UpdateChainConfig implements the MsgServiceServer interface. It replaces the Ethereum chain
+// config, which takes effect from the next block, as the state processor prepares every block with
+// the rules and precompiles of the current chain config.
+func (k *Keeper) UpdateChainConfig(
+	ctx context.Context, msg *types.MsgUpdateChainConfig,
+) (*types.MsgUpdateChainConfigResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	var chainConfig params.ChainConfig
+	if err := json.Unmarshal([]byte(msg.ChainConfig), &chainConfig); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid chain config: %s", err)
+	}
+
+	// Use a configuration plugin on the context of the message, so that the update is only written
+	// if the message succeeds.
+	if err := configuration.NewPlugin(k.storeKey).UpdateChainConfig(
+		sdk.UnwrapSDKContext(ctx), &chainConfig,
+	); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid chain config: %s", err)
+	}
+
+	return &types.MsgUpdateChainConfigResponse{}, nil
+}
+
+// UpdateParams implements the MsgServiceServer interface. It replaces the parameters of the x/evm
+// module, which are checked by the ante handler and by the state processor on every transaction.
+func (k *Keeper) UpdateParams(
+	ctx context.Context, msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid params: %s", err)
+	}
+
+	cp := configuration.NewPlugin(k.storeKey)
+	cp.Prepare(ctx)
+	cp.SetParams(msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ActivateDynamicPrecompile implements the MsgServiceServer interface. It schedules the activation
+// of a dynamic precompile of the binary at an address, which the state processor registers when it
+// prepares the blocks from the activation height.
+func (k *Keeper) ActivateDynamicPrecompile(
+	ctx context.Context, msg *types.MsgActivateDynamicPrecompile,
+) (*types.MsgActivateDynamicPrecompileResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := (&types.DynamicPrecompile{
+		Name: msg.Name, Address: msg.Address, ActivationHeight: msg.ActivationHeight,
+	}).ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid activation: %s", err)
+	}
+
+	// The precompile cannot shadow an account that has code or has sent transactions, but it can
+	// reuse the address of a deactivated precompile, which keeps the marker code.
+	sdkCtx, addr := sdk.UnwrapSDKContext(ctx), common.HexToAddress(msg.Address)
+	if sp := k.newStatePlugin(sdkCtx); sp.GetNonce(addr) != 0 ||
+		(len(sp.GetCode(addr)) != 0 && sp.GetCodeHash(addr) != ethprecompile.MarkerCodeHash) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid activation: %s is the address of an account", addr,
+		)
+	}
+
+	dp, err := k.host.GetPrecompilePlugin().(precompile.Plugin).ActivateDynamicPrecompile(
+		sdkCtx, msg.Name, addr, msg.ActivationHeight,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid activation: %s", err)
+	}
+
+	return &types.MsgActivateDynamicPrecompileResponse{Precompile: dp}, nil
+}
+
+// DeactivateDynamicPrecompile implements the MsgServiceServer interface. It schedules the
+// deactivation of the dynamic precompile at an address, which the state processor removes when it
+// prepares the blocks from the deactivation height.
+func (k *Keeper) DeactivateDynamicPrecompile(
+	ctx context.Context, msg *types.MsgDeactivateDynamicPrecompile,
+) (*types.MsgDeactivateDynamicPrecompileResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if !common.IsHexAddress(msg.Address) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s", msg.Address)
+	}
+
+	dp, err := k.host.GetPrecompilePlugin().(precompile.Plugin).DeactivateDynamicPrecompile(
+		sdk.UnwrapSDKContext(ctx), common.HexToAddress(msg.Address), msg.DeactivationHeight,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid deactivation: %s", err)
+	}
+
+	return &types.MsgDeactivateDynamicPrecompileResponse{Precompile: dp}, nil
+}
+
+// checkAuthority returns an error if the given authority is not the authority of the keeper, which
+// is the only signer allowed to update the chain config, the parameters and the dynamic
+// precompiles.
+func (k *Keeper) checkAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s",
+			k.authority.String(), authority,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"encoding/json"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/params"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UpdateChainConfig", func() {
+	var (
+		k         *keeper.Keeper
+		ctx       sdk.Context
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		var sk stakingkeeper.Keeper
+		ctx, ak, _, sk = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			ak, sk,
+			storetypes.NewKVStoreKey("evm"),
+			evmmempool.NewPolarisEthereumTxPool(),
+			func() *ethprecompile.Injector {
+				return ethprecompile.NewPrecompiles()
+			},
+			authority,
+		)
+		k.Setup(dbm.NewMemDB(), nil, nil, "", GinkgoT().TempDir(), log.NewNopLogger())
+
+		ctx = ctx.WithBlockHeight(0)
+		for _, plugin := range k.GetHost().GetAllPlugins() {
+			if plugin, ok := utils.GetAs[plugins.HasGenesis](plugin); ok {
+				plugin.InitGenesis(ctx, core.DefaultGenesis)
+			}
+		}
+		ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	})
+
+	// update submits the given chain config with the given authority.
+	update := func(authority string, chainConfig *params.ChainConfig) error {
+		bz, err := json.Marshal(chainConfig)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = k.UpdateChainConfig(ctx, &types.MsgUpdateChainConfig{
+			Authority: authority, ChainConfig: string(bz),
+		})
+		return err
+	}
+
+	// queryChainConfig returns the chain config stored in the keeper.
+	queryChainConfig := func() *params.ChainConfig {
+		resp, err := k.ChainConfig(ctx, &types.ChainConfigRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		var chainConfig params.ChainConfig
+		Expect(json.Unmarshal([]byte(resp.Config), &chainConfig)).To(Succeed())
+		return &chainConfig
+	}
+
+	It("should schedule a hard fork", func() {
+		cancunTime := uint64(2000)
+		chainConfig := *core.DefaultGenesis.Config
+		chainConfig.CancunTime = &cancunTime
+		Expect(update(authority.String(), &chainConfig)).To(Succeed())
+		Expect(*queryChainConfig().CancunTime).To(Equal(cancunTime))
+	})
+
+	It("should reject an update from another authority", func() {
+		cancunTime := uint64(2000)
+		chainConfig := *core.DefaultGenesis.Config
+		chainConfig.CancunTime = &cancunTime
+		err := update(sdk.AccAddress([]byte("other")).String(), &chainConfig)
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+		Expect(queryChainConfig().CancunTime).To(BeNil())
+	})
+
+	It("should reject a chain config that is not valid json", func() {
+		_, err := k.UpdateChainConfig(ctx, &types.MsgUpdateChainConfig{
+			Authority: authority.String(), ChainConfig: "{",
+		})
+		Expect(err).To(MatchError(sdkerrors.ErrInvalidRequest))
+		Expect(queryChainConfig()).To(Equal(core.DefaultGenesis.Config))
+	})
+
+	It("should reject an incompatible chain config", func() {
+		chainConfig := *core.DefaultGenesis.Config
+		chainConfig.ChainID = nil
+		Expect(update(authority.String(), &chainConfig)).To(MatchError(sdkerrors.ErrInvalidRequest))
+	})
+})
+
+var _ = Describe("ActivateDynamicPrecompile", func() {
+	var (
+		k         *keeper.Keeper
+		ctx       sdk.Context
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+		addr      = common.BytesToAddress([]byte{0x69})
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		var sk stakingkeeper.Keeper
+		ctx, ak, _, sk = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			ak, sk,
+			storetypes.NewKVStoreKey("evm"),
+			evmmempool.NewPolarisEthereumTxPool(),
+			func() *ethprecompile.Injector {
+				return ethprecompile.NewPrecompiles(&mockDynamic{
+					ethprecompile.NewBaseContract("[]", common.BytesToAddress([]byte{0x42})),
+				})
+			},
+			authority,
+		)
+		k.Setup(dbm.NewMemDB(), nil, nil, "", GinkgoT().TempDir(), log.NewNopLogger())
+		ctx = ctx.WithBlockHeight(10)
+	})
+
+	// activate submits the activation of the dynamic precompile at the address with the given
+	// authority.
+	activate := func(authority, name string) error {
+		_, err := k.ActivateDynamicPrecompile(ctx, &types.MsgActivateDynamicPrecompile{
+			Authority: authority, Name: name, Address: addr.Hex(), ActivationHeight: 12,
+		})
+		return err
+	}
+
+	// setAccount sets the nonce and code of the account at the address.
+	setAccount := func(nonce uint64, code []byte) {
+		sp := utils.MustGetAs[state.Plugin](k.GetHost().GetStatePlugin())
+		sp.Reset(ctx)
+		sp.CreateAccount(addr)
+		sp.SetNonce(addr, nonce)
+		sp.SetCode(addr, code)
+		sp.Finalize()
+	}
+
+	It("should activate a dynamic precompile", func() {
+		Expect(activate(authority.String(), "dynamic")).To(Succeed())
+	})
+
+	It("should reject an activation from another authority", func() {
+		err := activate(sdk.AccAddress([]byte("other")).String(), "dynamic")
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+	})
+
+	It("should reject an invalid activation", func() {
+		Expect(activate(authority.String(), "")).To(MatchError(sdkerrors.ErrInvalidRequest))
+	})
+
+	It("should reject the address of an account with code or a nonce", func() {
+		setAccount(0, []byte{0x1})
+		Expect(activate(authority.String(), "dynamic")).To(MatchError(sdkerrors.ErrInvalidRequest))
+		setAccount(1, nil)
+		Expect(activate(authority.String(), "dynamic")).To(MatchError(sdkerrors.ErrInvalidRequest))
+	})
+
+	It("should reuse the address of a deactivated precompile", func() {
+		setAccount(0, ethprecompile.MarkerCode)
+		Expect(activate(authority.String(), "dynamic")).To(Succeed())
+	})
+})
+
+type mockDynamic struct {
+	ethprecompile.BaseContract
+}
+
+func (md *mockDynamic) Name() string {
+	return "dynamic"
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/ethereum/go-ethereum"
+
+	"pkg.berachain.dev/polaris/eth/polar"
+)
+
+// Compile-time type assertion.
+var _ polar.StatusProvider = (*statusProvider)(nil)
+
+// netInfoClient is implemented by the CometBFT clients that expose the p2p info of the node.
+type netInfoClient interface {
+	NetInfo(context.Context) (*coretypes.ResultNetInfo, error)
+}
+
+// statusProvider reports the syncing and p2p status of the CometBFT node, which it queries through
+// the client of the given client context.
+type statusProvider struct {
+	clientCtx client.Context
+	logger    log.Logger
+}
+
+// newStatusProvider returns a new status provider that queries the CometBFT node through the
+// client of the given client context.
+func newStatusProvider(clientCtx client.Context, logger log.Logger) *statusProvider {
+	return &statusProvider{
+		clientCtx: clientCtx,
+		logger:    logger,
+	}
+}
+
+// SyncProgress implements `polar.StatusProvider`. CometBFT does not expose the heights of the
+// peers of the node, so the highest block is only an estimate: a node that is catching up reports
+// the block following its latest block as the highest block, which tells the clients that the
+// node is syncing but not how far behind it is.
+func (sp *statusProvider) SyncProgress() ethereum.SyncProgress {
+	if sp.clientCtx.Client == nil {
+		return ethereum.SyncProgress{}
+	}
+	status, err := sp.clientCtx.Client.Status(context.Background())
+	if err != nil {
+		sp.logger.Error("failed to query the CometBFT status", "err", err)
+		return ethereum.SyncProgress{}
+	}
+
+	info := status.SyncInfo
+	progress := ethereum.SyncProgress{
+		StartingBlock: uint64(info.EarliestBlockHeight),
+		CurrentBlock:  uint64(info.LatestBlockHeight),
+		HighestBlock:  uint64(info.LatestBlockHeight),
+	}
+	if info.CatchingUp {
+		// Estimate the highest block, as the actual one is not known.
+		progress.HighestBlock++
+	}
+	return progress
+}
+
+// Listening implements `polar.StatusProvider`.
+func (sp *statusProvider) Listening() bool {
+	netInfo := sp.netInfo()
+	if netInfo == nil {
+		return true
+	}
+	return netInfo.Listening
+}
+
+// PeerCount implements `polar.StatusProvider`.
+func (sp *statusProvider) PeerCount() uint64 {
+	netInfo := sp.netInfo()
+	if netInfo == nil {
+		return 0
+	}
+	return uint64(netInfo.NPeers)
+}
+
+// netInfo returns the p2p info of the CometBFT node, or nil if it is not available.
+func (sp *statusProvider) netInfo() *coretypes.ResultNetInfo {
+	nic, ok := sp.clientCtx.Client.(netInfoClient)
+	if !ok {
+		return nil
+	}
+	netInfo, err := nic.NetInfo(context.Background())
+	if err != nil {
+		sp.logger.Error("failed to query the CometBFT net info", "err", err)
+		return nil
+	}
+	return netInfo
+}
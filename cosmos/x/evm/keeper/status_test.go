@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/ethereum/go-ethereum"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mockCometRPC is a CometBFT client that only implements the status and net info queries.
+type mockCometRPC struct {
+	client.CometRPC
+	status  *coretypes.ResultStatus
+	netInfo *coretypes.ResultNetInfo
+}
+
+func (m *mockCometRPC) Status(context.Context) (*coretypes.ResultStatus, error) {
+	if m.status == nil {
+		return nil, errors.New("status unavailable")
+	}
+	return m.status, nil
+}
+
+func (m *mockCometRPC) NetInfo(context.Context) (*coretypes.ResultNetInfo, error) {
+	return m.netInfo, nil
+}
+
+var _ = Describe("StatusProvider", func() {
+	var rpc *mockCometRPC
+	var sp *statusProvider
+
+	BeforeEach(func() {
+		rpc = &mockCometRPC{
+			status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+				EarliestBlockHeight: 1,
+				LatestBlockHeight:   10,
+			}},
+			netInfo: &coretypes.ResultNetInfo{Listening: true, NPeers: 4},
+		}
+		sp = newStatusProvider(client.Context{}.WithClient(rpc), log.NewNopLogger())
+	})
+
+	It("should report a synced node", func() {
+		Expect(sp.SyncProgress()).To(Equal(ethereum.SyncProgress{
+			StartingBlock: 1, CurrentBlock: 10, HighestBlock: 10,
+		}))
+	})
+
+	It("should report a catching up node", func() {
+		rpc.status.SyncInfo.CatchingUp = true
+		progress := sp.SyncProgress()
+		Expect(progress.CurrentBlock).To(BeNumerically("<", progress.HighestBlock))
+		// the highest block is estimated, as the heights of the peers are not known.
+		Expect(progress.HighestBlock).To(Equal(uint64(11)))
+	})
+
+	It("should report the p2p status of the node", func() {
+		Expect(sp.Listening()).To(BeTrue())
+		Expect(sp.PeerCount()).To(Equal(uint64(4)))
+	})
+
+	It("should fall back to a synced node without a status", func() {
+		rpc.status = nil
+		Expect(sp.SyncProgress()).To(Equal(ethereum.SyncProgress{}))
+	})
+})
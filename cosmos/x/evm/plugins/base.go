@@ -23,6 +23,7 @@ package plugins
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/core"
 )
 
@@ -39,3 +40,11 @@ type HasGenesis interface {
 	InitGenesis(sdk.Context, *core.Genesis)
 	ExportGenesis(sdk.Context, *core.Genesis)
 }
+
+// HasModuleGenesis represents the base class that all x/evm Polaris plugins which keep the state
+// of the x/evm module that is not part of the Ethereum genesis must implement
+
+type HasModuleGenesis interface {
+	InitModuleGenesis(sdk.Context, *types.GenesisState)
+	ExportModuleGenesis(sdk.Context, *types.GenesisState)
+}
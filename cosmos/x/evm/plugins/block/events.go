@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events"
+	"pkg.berachain.dev/polaris/eth/core"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// Compile-time type assertion.
+var _ core.HostLogsProvider = (*plugin)(nil)
+
+// SetLogFactory sets the factory used to build Eth logs from the Cosmos events recorded during the
+// block.
+func (p *plugin) SetLogFactory(plf events.PrecompileLogFactory) {
+	p.plf = plf
+}
+
+// SetCosmosEventLogs sets whether the registered Cosmos events recorded during the block are
+// attached to the Polaris block as host logs. As this changes the blocks, it must be the same on
+// every node of the network.
+func (p *plugin) SetCosmosEventLogs(enabled bool) {
+	p.cosmosEventLogs = enabled
+}
+
+// RecordEvents builds Eth logs from the given Cosmos events, if enabled, so that they are attached
+// to the Polaris block when it is finalized. The events that are not mapped to a registered Eth
+// event are skipped.
+func (p *plugin) RecordEvents(evs sdk.Events) {
+	if !p.cosmosEventLogs || p.plf == nil {
+		return
+	}
+
+	for i := range evs {
+		log, err := p.plf.Build(&evs[i])
+		if err != nil {
+			if !errors.Is(err, events.ErrEthEventNotRegistered) {
+				p.ctx.Logger().Error("cannot convert Cosmos event to Eth log",
+					"event", evs[i].Type, "err", err)
+			}
+			continue
+		}
+		p.hostLogs = append(p.hostLogs, log)
+	}
+}
+
+// HostLogs implements `core.HostLogsProvider`.
+func (p *plugin) HostLogs() []*coretypes.Log {
+	return p.hostLogs
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events/mock"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Events", func() {
+	var p *plugin
+	evs := sdk.Events{sdk.NewEvent("eth-event"), sdk.NewEvent("non-eth-event")}
+
+	BeforeEach(func() {
+		_, _, _, sk := testutil.SetupMinimalKeepers()
+		p = utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey, sk))
+		p.SetLogFactory(mock.NewPrecompileLogFactory())
+		p.Prepare(testutil.NewContext())
+	})
+
+	It("should not record events by default", func() {
+		p.RecordEvents(evs)
+		Expect(p.HostLogs()).To(BeEmpty())
+	})
+
+	It("should record the registered events when enabled", func() {
+		p.SetCosmosEventLogs(true)
+		p.RecordEvents(evs)
+		Expect(p.HostLogs()).To(HaveLen(1))
+
+		// the recorded events are reset for every block
+		p.Prepare(testutil.NewContext())
+		Expect(p.HostLogs()).To(BeEmpty())
+	})
+})
@@ -30,8 +30,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 )
 
 type Plugin interface {
@@ -41,6 +43,12 @@ type Plugin interface {
 
 	// SetQueryContextFn sets the function used for querying historical block headers.
 	SetQueryContextFn(fn func(height int64, prove bool) (sdk.Context, error))
+	// SetLogFactory sets the factory used to build Eth logs from Cosmos events.
+	SetLogFactory(events.PrecompileLogFactory)
+	// SetCosmosEventLogs sets whether the recorded Cosmos events are attached to the block.
+	SetCosmosEventLogs(bool)
+	// RecordEvents records the given Cosmos events, emitted outside of the EVM, in the block.
+	RecordEvents(sdk.Events)
 }
 
 type plugin struct {
@@ -52,6 +60,13 @@ type plugin struct {
 	getQueryContext func(height int64, prove bool) (sdk.Context, error)
 	// sk represents the cosmos staking keeper.
 	sk StakingKeeper
+
+	// plf is used to build Eth logs from the Cosmos events recorded during the block.
+	plf events.PrecompileLogFactory
+	// cosmosEventLogs is true if the recorded Cosmos events are attached to the block.
+	cosmosEventLogs bool
+	// hostLogs are the Eth logs built from the Cosmos events recorded during the current block.
+	hostLogs []*coretypes.Log
 }
 
 func NewPlugin(storekey storetypes.StoreKey, sk StakingKeeper) Plugin {
@@ -64,6 +79,7 @@ func NewPlugin(storekey storetypes.StoreKey, sk StakingKeeper) Plugin {
 // Prepare implements core.BlockPlugin.
 func (p *plugin) Prepare(ctx context.Context) {
 	p.ctx = sdk.UnwrapSDKContext(ctx)
+	p.hostLogs = nil
 }
 
 // BaseFee implements core.BlockPlugin.
@@ -23,6 +23,7 @@ package configuration
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/core"
 )
 
@@ -39,3 +40,15 @@ func (p *plugin) ExportGenesis(ctx sdk.Context, ethGen *core.Genesis) {
 	p.Prepare(ctx)
 	ethGen.Config = p.ChainConfig()
 }
+
+// InitModuleGenesis sets the parameters of the x/evm module from the genesis state.
+func (p *plugin) InitModuleGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	p.Prepare(ctx)
+	p.SetParams(genState.Params)
+}
+
+// ExportModuleGenesis exports the parameters of the x/evm module to the genesis state.
+func (p *plugin) ExportModuleGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	p.Prepare(ctx)
+	genState.Params = p.Params()
+}
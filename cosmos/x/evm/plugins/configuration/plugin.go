@@ -29,6 +29,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	"pkg.berachain.dev/polaris/eth/params"
@@ -38,8 +39,13 @@ import (
 type Plugin interface {
 	plugins.Base
 	plugins.HasGenesis
+	plugins.HasModuleGenesis
 	core.ConfigurationPlugin
+	core.AccessController
 	SetChainConfig(*params.ChainConfig)
+	UpdateChainConfig(sdk.Context, *params.ChainConfig) error
+	Params() *types.Params
+	SetParams(*types.Params)
 }
 
 // plugin implements the core.ConfigurationPlugin interface.
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/params"
 	"pkg.berachain.dev/polaris/lib/encoding"
 )
@@ -45,3 +46,35 @@ func (p *plugin) SetChainConfig(chainConfig *params.ChainConfig) {
 	}
 	p.paramsStore.Set([]byte{types.ChainConfigPrefix}, bz)
 }
+
+// Params returns the parameters of the x/evm module, or the default parameters if they are not set.
+func (p *plugin) Params() *types.Params {
+	bz := p.paramsStore.Get([]byte{types.ParamsKey})
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	moduleParams := new(types.Params)
+	if err := moduleParams.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return moduleParams
+}
+
+// SetParams sets the parameters of the x/evm module.
+func (p *plugin) SetParams(moduleParams *types.Params) {
+	bz, err := moduleParams.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	p.paramsStore.Set([]byte{types.ParamsKey}, bz)
+}
+
+// CanSendTransaction implements the core.AccessController interface.
+func (p *plugin) CanSendTransaction(addr common.Address) bool {
+	return p.Params().CanSendTransaction(addr)
+}
+
+// CanCreateContract implements the core.AccessController interface.
+func (p *plugin) CanCreateContract(addr common.Address) bool {
+	return p.Params().CanCreateContract(addr)
+}
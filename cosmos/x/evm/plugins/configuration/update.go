@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package configuration
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/eth/params"
+)
+
+// UpdateChainConfig replaces the chain config with the given chain config, which takes effect from
+// the next block. It returns an error if the given chain config changes the chain id, does not
+// schedule the hard forks in order, or changes a hard fork that is already activated at the block
+// of the given context.
+func (p *plugin) UpdateChainConfig(ctx sdk.Context, chainConfig *params.ChainConfig) error {
+	p.Prepare(ctx)
+	current := p.ChainConfig()
+
+	if chainConfig.ChainID == nil || current.ChainID.Cmp(chainConfig.ChainID) != 0 {
+		return fmt.Errorf(
+			"chain id cannot be changed: have %s, want %s", current.ChainID, chainConfig.ChainID,
+		)
+	}
+	if err := chainConfig.CheckConfigForkOrder(); err != nil {
+		return err
+	}
+	if err := current.CheckCompatible(
+		chainConfig, uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()),
+	); err != nil {
+		return err
+	}
+
+	p.SetChainConfig(chainConfig)
+	return nil
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package configuration
+
+import (
+	"math/big"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UpdateChainConfig", func() {
+	var (
+		p   Plugin
+		ctx sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext().WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+		p = NewPlugin(storetypes.NewKVStoreKey("evm"))
+		p.Prepare(ctx)
+		p.SetChainConfig(params.DefaultChainConfig)
+	})
+
+	It("should schedule a hard fork", func() {
+		cancunTime := uint64(2000)
+		chainConfig := *params.DefaultChainConfig
+		chainConfig.CancunTime = &cancunTime
+		Expect(p.UpdateChainConfig(ctx, &chainConfig)).To(Succeed())
+		Expect(*p.ChainConfig().CancunTime).To(Equal(cancunTime))
+
+		// The scheduled hard fork can be rescheduled before it is activated.
+		cancunTime = 3000
+		Expect(p.UpdateChainConfig(ctx, &chainConfig)).To(Succeed())
+		Expect(*p.ChainConfig().CancunTime).To(Equal(cancunTime))
+	})
+
+	It("should not change a hard fork that is already activated", func() {
+		chainConfig := *params.DefaultChainConfig
+		chainConfig.LondonBlock = big.NewInt(20)
+		Expect(p.UpdateChainConfig(ctx, &chainConfig)).ToNot(Succeed())
+
+		cancunTime := uint64(500)
+		chainConfig = *params.DefaultChainConfig
+		chainConfig.CancunTime = &cancunTime
+		Expect(p.UpdateChainConfig(ctx, &chainConfig)).ToNot(Succeed())
+		Expect(p.ChainConfig().LondonBlock.Uint64()).To(BeZero())
+		Expect(p.ChainConfig().CancunTime).To(BeNil())
+	})
+
+	It("should not change the chain id", func() {
+		chainConfig := *params.DefaultChainConfig
+		chainConfig.ChainID = big.NewInt(1)
+		Expect(p.UpdateChainConfig(ctx, &chainConfig)).ToNot(Succeed())
+
+		chainConfig.ChainID = nil
+		Expect(p.UpdateChainConfig(ctx, &chainConfig)).ToNot(Succeed())
+	})
+
+	It("should not schedule the hard forks out of order", func() {
+		cancunTime := uint64(2000)
+		chainConfig := *params.DefaultChainConfig
+		chainConfig.ShanghaiTime = nil
+		chainConfig.CancunTime = &cancunTime
+		Expect(p.UpdateChainConfig(ctx, &chainConfig)).ToNot(Succeed())
+	})
+})
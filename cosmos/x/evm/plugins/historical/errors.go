@@ -24,4 +24,9 @@ import "errors"
 
 var (
 	ErrBlockNotFound = errors.New("block not found, is your node pruned?")
+	// ErrPruneConsensusStore is returned when a retention policy is set while the historical
+	// data is stored in the consensus store, which must not depend on node-local config.
+	ErrPruneConsensusStore = errors.New(
+		"historical data in the consensus store cannot be pruned, set a node-local database",
+	)
 )
@@ -33,6 +33,9 @@ func (p *plugin) InitGenesis(ctx sdk.Context, ethGen *core.Genesis) {
 	if err := p.StoreBlock(ethGen.ToBlock()); err != nil {
 		panic(err)
 	}
+	if err := p.Commit(); err != nil {
+		panic(err)
+	}
 }
 
 func (p *plugin) ExportGenesis(_ sdk.Context, _ *core.Genesis) {}
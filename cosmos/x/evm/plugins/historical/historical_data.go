@@ -21,6 +21,7 @@
 package historical
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/store/prefix"
@@ -41,10 +42,17 @@ import (
 // StoreBlock implements `core.HistoricalPlugin`.
 func (p *plugin) StoreBlock(block *coretypes.Block) error {
 	blockNum := block.NumberU64()
+	store := p.writeStore()
+
+	// recover the off-chain store if it is not synced with the previous block number.
+	if blockNum > 0 {
+		if err := p.recover(blockNum); err != nil {
+			return err
+		}
+	}
 
 	// store block hash to block number.
 	numBz := sdk.Uint64ToBigEndian(blockNum)
-	store := p.ctx.KVStore(p.storeKey)
 
 	// store block num to block
 	blockBz, err := rlp.EncodeToBytes(block)
@@ -57,20 +65,54 @@ func (p *plugin) StoreBlock(block *coretypes.Block) error {
 	prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).Set(block.Hash().Bytes(), numBz)
 
 	// store the version offchain for consistency.
-	offChainNum := sdk.BigEndianToUint64(store.Get([]byte{types.VersionKey}))
-	if blockNum > 0 && offChainNum != blockNum-1 {
-		panic(
-			fmt.Errorf(
-				"off-chain store's latest block number %d is not synced with previous block number %d",
-				offChainNum,
-				blockNum-1,
-			),
+	store.Set([]byte{types.VersionKey}, numBz)
+	return nil
+}
+
+// recover makes the off-chain store consistent before storing the block with the given number,
+// in case its latest block number diverged from the committed block number. If the node crashed
+// after storing a block off-chain but before committing it, the block is replayed on restart, so
+// the blocks from the given number onwards are rewound. If the off-chain store is behind (i.e it
+// was wiped or newly enabled) the missing blocks cannot be recovered, so the gap is only logged.
+func (p *plugin) recover(blockNum uint64) error {
+	offChainNum := sdk.BigEndianToUint64(p.store().Get([]byte{types.VersionKey}))
+	switch {
+	case offChainNum >= blockNum:
+		p.ctx.Logger().Info(
+			"rewinding off-chain historical store", "latest", offChainNum, "block", blockNum,
+		)
+		for num := blockNum; num <= offChainNum; num++ {
+			block, err := p.GetBlockByNumber(num)
+			if errors.Is(err, core.ErrBlockNotFound) || errors.Is(err, core.ErrHistoryPruned) {
+				continue
+			} else if err != nil {
+				return errorslib.Wrapf(err, "failed to rewind block %d", num)
+			}
+			p.deleteBlock(block)
+		}
+	case offChainNum < blockNum-1:
+		p.ctx.Logger().Error(
+			"off-chain historical store is missing blocks",
+			"latest", offChainNum, "block", blockNum,
 		)
 	}
-	store.Set([]byte{types.VersionKey}, numBz)
 	return nil
 }
 
+// deleteBlock deletes the given block, its receipts, its transactions, and its hash index.
+func (p *plugin) deleteBlock(block *coretypes.Block) {
+	store := p.writeStore()
+	numBz := sdk.Uint64ToBigEndian(block.NumberU64())
+	prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Delete(numBz)
+	prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).Delete(block.Hash().Bytes())
+	prefix.NewStore(store, []byte{types.BlockHashKeyToReceiptsPrefix}).Delete(block.Hash().Bytes())
+
+	txStore := prefix.NewStore(store, []byte{types.TxHashKeyToTxPrefix})
+	for _, tx := range block.Transactions() {
+		txStore.Delete(tx.Hash().Bytes())
+	}
+}
+
 // StoreReceipts implements `core.HistoricalPlugin`.
 func (p *plugin) StoreReceipts(blockHash common.Hash, receipts coretypes.Receipts) error {
 	// store block hash to receipts.
@@ -81,7 +123,7 @@ func (p *plugin) StoreReceipts(blockHash common.Hash, receipts coretypes.Receipt
 		)
 		return err
 	}
-	prefix.NewStore(p.ctx.KVStore(p.storeKey),
+	prefix.NewStore(p.writeStore(),
 		[]byte{types.BlockHashKeyToReceiptsPrefix}).Set(blockHash.Bytes(), receiptsBz)
 
 	return nil
@@ -92,7 +134,7 @@ func (p *plugin) StoreTransactions(
 	blockNum uint64, blockHash common.Hash, txs coretypes.Transactions,
 ) error {
 	// store all txns in the block.
-	txStore := prefix.NewStore(p.ctx.KVStore(p.storeKey), []byte{types.TxHashKeyToTxPrefix})
+	txStore := prefix.NewStore(p.writeStore(), []byte{types.TxHashKeyToTxPrefix})
 	for txIndex, tx := range txs {
 		txLookupEntry := &coretypes.TxLookupEntry{
 			Tx:        tx,
@@ -117,9 +159,16 @@ func (p *plugin) StoreTransactions(
 
 // GetBlockByNumber returns the block at the given height.
 func (p *plugin) GetBlockByNumber(number uint64) (*coretypes.Block, error) {
-	store := p.ctx.KVStore(p.storeKey)
+	if number < p.EarliestBlockNumber() {
+		return nil, core.ErrHistoryPruned
+	}
+
+	store := p.store()
 	numBz := sdk.Uint64ToBigEndian(number)
 	blockBz := prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Get(numBz)
+	if blockBz == nil {
+		return nil, core.ErrBlockNotFound
+	}
 	block := &coretypes.Block{}
 	err := rlp.DecodeBytes(blockBz, block)
 	if err != nil {
@@ -130,11 +179,14 @@ func (p *plugin) GetBlockByNumber(number uint64) (*coretypes.Block, error) {
 
 // GetBlockByHash returns the block at the given hash.
 func (p *plugin) GetBlockByHash(blockHash common.Hash) (*coretypes.Block, error) {
-	store := p.ctx.KVStore(p.storeKey)
+	store := p.store()
 	numBz := prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).Get(blockHash.Bytes())
 	if numBz == nil {
 		return nil, core.ErrBlockNotFound
 	}
+	if sdk.BigEndianToUint64(numBz) < p.EarliestBlockNumber() {
+		return nil, core.ErrHistoryPruned
+	}
 
 	blockBz := prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Get(numBz)
 	block := &coretypes.Block{}
@@ -149,8 +201,11 @@ func (p *plugin) GetBlockByHash(blockHash common.Hash) (*coretypes.Block, error)
 // GetTransactionByHash returns the transaction lookup entry with the given hash.
 func (p *plugin) GetTransactionByHash(txHash common.Hash) (*coretypes.TxLookupEntry, error) {
 	// get tx from off chain.
-	tleBz := prefix.NewStore(p.ctx.KVStore(p.storeKey), []byte{types.TxHashKeyToTxPrefix}).Get(txHash.Bytes())
+	tleBz := prefix.NewStore(p.store(), []byte{types.TxHashKeyToTxPrefix}).Get(txHash.Bytes())
 	if tleBz == nil {
+		if p.IsPruned(txHash) {
+			return nil, core.ErrHistoryPruned
+		}
 		return nil, core.ErrTxNotFound
 	}
 	tle := &coretypes.TxLookupEntry{}
@@ -164,9 +219,12 @@ func (p *plugin) GetTransactionByHash(txHash common.Hash) (*coretypes.TxLookupEn
 // GetReceiptsByHash returns the receipts with the given block hash.
 func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (coretypes.Receipts, error) {
 	// get receipts from off chain.
-	receiptsBz := prefix.NewStore(p.ctx.KVStore(p.storeKey),
+	receiptsBz := prefix.NewStore(p.store(),
 		[]byte{types.BlockHashKeyToReceiptsPrefix}).Get(blockHash.Bytes())
 	if receiptsBz == nil {
+		if p.IsPruned(blockHash) {
+			return nil, core.ErrHistoryPruned
+		}
 		return nil, fmt.Errorf("failed to find receipts for block hash %s", blockHash.Hex())
 	}
 	receipts, err := coretypes.UnmarshalReceipts(receiptsBz)
@@ -174,16 +232,26 @@ func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (coretypes.Receipts, e
 		return nil, errorslib.Wrapf(err, "failed to unmarshal receipts for block hash %s", blockHash.Hex())
 	}
 
-	// get block to derive fields on receipts
+	// get block to derive fields on receipts, the host receipt of the block, if any, follows the
+	// receipts of the transactions.
 	block, err := p.GetBlockByHash(blockHash)
 	if err != nil {
 		return nil, err
 	}
-	if err = receipts.DeriveFields(
+	txReceipts := receipts
+	if numTxs := len(block.Transactions()); len(receipts) == numTxs+1 {
+		txReceipts = receipts[:numTxs]
+	}
+	if err = txReceipts.DeriveFields(
 		p.cp.ChainConfig(), blockHash, block.NumberU64(), block.Time(), block.BaseFee(), block.Transactions(),
 	); err != nil {
 		return nil, err
 	}
+	if len(receipts) > len(txReceipts) {
+		coretypes.DeriveHostReceiptFields(
+			receipts[len(txReceipts)], blockHash, block.Number(), txReceipts,
+		)
+	}
 
 	return receipts, nil
 }
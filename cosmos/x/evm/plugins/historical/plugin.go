@@ -23,6 +23,9 @@ package historical
 import (
 	"context"
 
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/dbadapter"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,7 +38,15 @@ import (
 type Plugin interface {
 	plugins.Base
 	core.HistoricalPlugin
+	core.HistoricalPruner
+	core.HistoricalCommitter
 	plugins.HasGenesis
+	// SetRetentionPolicy sets the retention policy of the historical data, which is only
+	// allowed once the off-chain database is set.
+	SetRetentionPolicy(RetentionPolicy) error
+	// SetOffChainDB sets the node-local database used to store the historical data. If it is
+	// never set, the historical data is stored in the consensus store.
+	SetOffChainDB(dbm.DB)
 }
 
 // plugin keeps track of polaris blocks via headers.
@@ -48,15 +59,17 @@ type plugin struct {
 	bp core.BlockPlugin
 	// storekey is the store key for the header store.
 	storeKey storetypes.StoreKey
-	// TODO: reenable offchain
-	//  `offchainStore` is the offchain store, used for accessing offchain data.
-	// offchainStoreKey storetypes.StoreKey
+	// retention is the retention policy of the historical data, which keeps everything by default.
+	retention RetentionPolicy
+	// offchainDB is the node-local database used for the historical data, if set.
+	offchainDB dbm.DB
+	// batch holds the writes to the off-chain database that are not committed yet.
+	batch dbm.Batch
 }
 
-// NewPlugin creates a new instance of the block plugin from the given context.
+// NewPlugin creates a new instance of the historical plugin.
 func NewPlugin(
-	cp core.ConfigurationPlugin, bp core.BlockPlugin,
-	_ storetypes.StoreKey, storekey storetypes.StoreKey,
+	cp core.ConfigurationPlugin, bp core.BlockPlugin, storekey storetypes.StoreKey,
 ) Plugin {
 	return &plugin{
 		cp:       cp,
@@ -70,4 +83,66 @@ func (p *plugin) Prepare(ctx context.Context) {
 	p.ctx = sdk.UnwrapSDKContext(ctx)
 }
 
+// SetOffChainDB implements Plugin.
+func (p *plugin) SetOffChainDB(db dbm.DB) {
+	p.offchainDB = db
+}
+
+// store returns the store that the historical data is read from, which is the off-chain store if
+// set, and the consensus store otherwise.
+func (p *plugin) store() storetypes.KVStore {
+	if p.offchainDB != nil {
+		return dbadapter.Store{DB: p.offchainDB}
+	}
+	return p.ctx.KVStore(p.storeKey)
+}
+
+// writeStore returns the store that the historical data is written to. The writes to the off-chain
+// store are batched until `Commit`, so that they are only read once committed.
+func (p *plugin) writeStore() storetypes.KVStore {
+	if p.offchainDB == nil {
+		return p.ctx.KVStore(p.storeKey)
+	}
+	if p.batch == nil {
+		p.batch = p.offchainDB.NewBatch()
+	}
+	return batchStore{Store: dbadapter.Store{DB: p.offchainDB}, batch: p.batch}
+}
+
+// Commit implements `core.HistoricalCommitter`. It writes the batched writes to the off-chain
+// store, and syncs them to disk, so that a block is either fully stored or not at all.
+func (p *plugin) Commit() error {
+	if p.batch == nil {
+		return nil
+	}
+	defer func() { p.batch = nil }()
+	if err := p.batch.WriteSync(); err != nil {
+		_ = p.batch.Close()
+		return err
+	}
+	return p.batch.Close()
+}
+
 func (p *plugin) IsPlugin() {}
+
+// batchStore is an off-chain store that writes to a batch of its database.
+type batchStore struct {
+	dbadapter.Store
+	batch dbm.Batch
+}
+
+// Set implements `storetypes.KVStore`.
+func (bs batchStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	if err := bs.batch.Set(key, value); err != nil {
+		panic(err)
+	}
+}
+
+// Delete implements `storetypes.KVStore`.
+func (bs batchStore) Delete(key []byte) {
+	if err := bs.batch.Delete(key); err != nil {
+		panic(err)
+	}
+}
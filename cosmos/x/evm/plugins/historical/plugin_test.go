@@ -22,12 +22,16 @@ package historical
 
 import (
 	"math/big"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/trie"
 
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	"pkg.berachain.dev/polaris/eth/core/mock"
@@ -49,7 +53,7 @@ var _ = Describe("Historical Data", func() {
 		cp := mock.NewConfigurationPluginMock()
 		bp := mock.NewBlockPluginMock()
 
-		p = utils.MustGetAs[*plugin](NewPlugin(cp, bp, nil, testutil.EvmKey))
+		p = utils.MustGetAs[*plugin](NewPlugin(cp, bp, testutil.EvmKey))
 		p.InitGenesis(ctx, core.DefaultGenesis)
 	})
 
@@ -115,6 +119,213 @@ var _ = Describe("Historical Data", func() {
 			Expect(tleByHash.BlockNum).To(Equal(uint64(1)))
 			Expect(tleByHash.Tx.Hash()).To(Equal(txHash))
 		})
+
+		It("should not index the host receipt as a transaction", func() {
+			header := &coretypes.Header{Number: big.NewInt(1), GasLimit: 1000}
+			receipts := coretypes.Receipts{
+				coretypes.NewHostReceipt(header.Number, 0, 0, []*coretypes.Log{{}}),
+			}
+			block := coretypes.NewBlock(header, nil, nil, receipts, trie.NewStackTrie(nil))
+
+			Expect(p.StoreBlock(block)).To(Succeed())
+			Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+			Expect(p.StoreTransactions(1, block.Hash(), block.Transactions())).To(Succeed())
+
+			// the host receipt is served with the receipts of its block only.
+			receiptsByHash, err := p.GetReceiptsByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receiptsByHash).To(HaveLen(1))
+			Expect(receiptsByHash[0].TxHash).To(Equal(coretypes.HostReceiptHash(header.Number)))
+			_, err = p.GetTransactionByHash(coretypes.HostReceiptHash(header.Number))
+			Expect(err).To(MatchError(core.ErrTxNotFound))
+		})
 	})
 
+	When("Off-chain store", func() {
+		newBlock := func(number int64, nonce uint64) *coretypes.Block {
+			tx := coretypes.NewTransaction(
+				nonce, common.Address{0x1}, big.NewInt(1), 1000, big.NewInt(1), []byte{0x12},
+			)
+			header := &coretypes.Header{Number: big.NewInt(number), GasLimit: 1000}
+			return coretypes.NewBlock(
+				header, coretypes.Transactions{tx}, nil, nil, trie.NewStackTrie(nil),
+			)
+		}
+
+		BeforeEach(func() {
+			p.SetOffChainDB(dbm.NewMemDB())
+			p.InitGenesis(ctx, core.DefaultGenesis)
+		})
+
+		It("should not write to the consensus store", func() {
+			Expect(p.StoreBlock(newBlock(1, 0))).To(Succeed())
+			Expect(p.Commit()).To(Succeed())
+			Expect(ctx.KVStore(testutil.EvmKey).Has(
+				append([]byte{types.BlockNumKeyToBlockPrefix}, sdk.Uint64ToBigEndian(1)...),
+			)).To(BeFalse())
+			_, err := p.GetBlockByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should rewind blocks that are replayed after a crash", func() {
+			stale := newBlock(1, 0)
+			Expect(p.StoreBlock(stale)).To(Succeed())
+			Expect(p.StoreTransactions(1, stale.Hash(), stale.Transactions())).To(Succeed())
+			Expect(p.Commit()).To(Succeed())
+
+			replayed := newBlock(1, 1)
+			Expect(p.StoreBlock(replayed)).To(Succeed())
+			Expect(p.StoreTransactions(1, replayed.Hash(), replayed.Transactions())).To(Succeed())
+			Expect(p.Commit()).To(Succeed())
+
+			block, err := p.GetBlockByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Hash()).To(Equal(replayed.Hash()))
+			_, err = p.GetBlockByHash(stale.Hash())
+			Expect(err).To(MatchError(core.ErrBlockNotFound))
+			_, err = p.GetTransactionByHash(stale.Transactions()[0].Hash())
+			Expect(err).To(MatchError(core.ErrTxNotFound))
+		})
+
+		It("should only write the block, its receipts and its transactions on commit", func() {
+			block := newBlock(1, 0)
+			receipts := coretypes.Receipts{
+				{Type: 2, Status: 1, TxHash: block.Transactions()[0].Hash(), BlockNumber: big.NewInt(1)},
+			}
+			Expect(p.StoreBlock(block)).To(Succeed())
+			Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+			Expect(p.StoreTransactions(1, block.Hash(), block.Transactions())).To(Succeed())
+
+			_, err := p.GetBlockByNumber(1)
+			Expect(err).To(MatchError(core.ErrBlockNotFound))
+			_, err = p.GetTransactionByHash(block.Transactions()[0].Hash())
+			Expect(err).To(MatchError(core.ErrTxNotFound))
+
+			Expect(p.Commit()).To(Succeed())
+			_, err = p.GetBlockByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = p.GetReceiptsByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = p.GetTransactionByHash(block.Transactions()[0].Hash())
+			Expect(err).ToNot(HaveOccurred())
+
+			// committing without any stored data is a no-op.
+			Expect(p.Commit()).To(Succeed())
+		})
+
+		It("should not panic if the off-chain store is behind", func() {
+			Expect(p.StoreBlock(newBlock(5, 0))).To(Succeed())
+			Expect(p.Commit()).To(Succeed())
+			_, err := p.GetBlockByNumber(5)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = p.GetBlockByNumber(4)
+			Expect(err).To(MatchError(core.ErrBlockNotFound))
+		})
+	})
+
+	When("Pruning", func() {
+		var blocks []*coretypes.Block
+
+		BeforeEach(func() {
+			p.SetOffChainDB(dbm.NewMemDB())
+			p.InitGenesis(ctx, core.DefaultGenesis)
+			blocks = []*coretypes.Block{}
+			for i := int64(1); i <= 3; i++ {
+				header := &coretypes.Header{
+					Number:   big.NewInt(i),
+					GasLimit: 1000,
+					Time:     uint64(i * 10),
+				}
+				tx := coretypes.NewTransaction(
+					uint64(i), common.Address{0x1}, big.NewInt(1), 1000, big.NewInt(1), []byte{0x12},
+				)
+				receipts := coretypes.Receipts{
+					{Type: 2, Status: 1, TxHash: tx.Hash(), BlockNumber: big.NewInt(i)},
+				}
+				block := coretypes.NewBlock(
+					header, coretypes.Transactions{tx}, nil, receipts, trie.NewStackTrie(nil),
+				)
+
+				Expect(p.StoreBlock(block)).To(Succeed())
+				Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+				Expect(p.StoreTransactions(uint64(i), block.Hash(), block.Transactions())).To(Succeed())
+				Expect(p.Commit()).To(Succeed())
+				blocks = append(blocks, block)
+			}
+		})
+
+		It("should not prune the consensus store", func() {
+			p.SetOffChainDB(nil)
+			Expect(p.SetRetentionPolicy(RetentionPolicy{Blocks: 2})).To(
+				MatchError(ErrPruneConsensusStore),
+			)
+			Expect(p.SetRetentionPolicy(RetentionPolicy{Tombstones: 1})).To(Succeed())
+		})
+
+		It("should not prune without a retention policy", func() {
+			Expect(p.Prune(blocks[2])).To(Succeed())
+			Expect(p.Commit()).To(Succeed())
+			Expect(p.EarliestBlockNumber()).To(Equal(uint64(0)))
+			_, err := p.GetBlockByNumber(0)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should keep the last N blocks", func() {
+			Expect(p.SetRetentionPolicy(RetentionPolicy{Blocks: 2})).To(Succeed())
+			Expect(p.Prune(blocks[2])).To(Succeed())
+			Expect(p.Commit()).To(Succeed())
+			Expect(p.EarliestBlockNumber()).To(Equal(uint64(2)))
+
+			_, err := p.GetBlockByNumber(1)
+			Expect(err).To(MatchError(core.ErrHistoryPruned))
+			_, err = p.GetBlockByHash(blocks[0].Hash())
+			Expect(err).To(MatchError(core.ErrHistoryPruned))
+			_, err = p.GetReceiptsByHash(blocks[0].Hash())
+			Expect(err).To(MatchError(core.ErrHistoryPruned))
+			_, err = p.GetTransactionByHash(blocks[0].Transactions()[0].Hash())
+			Expect(err).To(MatchError(core.ErrHistoryPruned))
+			Expect(p.IsPruned(blocks[0].Hash())).To(BeTrue())
+
+			block, err := p.GetBlockByNumber(2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Hash()).To(Equal(blocks[1].Hash()))
+			_, err = p.GetTransactionByHash(blocks[1].Transactions()[0].Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p.IsPruned(blocks[1].Hash())).To(BeFalse())
+
+			_, err = p.GetTransactionByHash(common.Hash{0x1})
+			Expect(err).To(MatchError(core.ErrTxNotFound))
+		})
+
+		It("should keep the blocks within the maximum age", func() {
+			Expect(p.SetRetentionPolicy(RetentionPolicy{Age: 15 * time.Second})).To(Succeed())
+			Expect(p.Prune(blocks[2])).To(Succeed())
+			Expect(p.Commit()).To(Succeed())
+			Expect(p.EarliestBlockNumber()).To(Equal(uint64(2)))
+
+			_, err := p.GetBlockByNumber(1)
+			Expect(err).To(MatchError(core.ErrHistoryPruned))
+			_, err = p.GetBlockByNumber(2)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should expire the tombstones of old pruned blocks", func() {
+			Expect(p.SetRetentionPolicy(RetentionPolicy{Blocks: 1, Tombstones: 1})).To(Succeed())
+			Expect(p.Prune(blocks[2])).To(Succeed())
+			Expect(p.Commit()).To(Succeed())
+			Expect(p.EarliestBlockNumber()).To(Equal(uint64(3)))
+
+			// the tombstones of block 1 expired, while those of block 2 are kept.
+			Expect(p.IsPruned(blocks[0].Hash())).To(BeFalse())
+			Expect(p.IsPruned(blocks[0].Transactions()[0].Hash())).To(BeFalse())
+			_, err := p.GetTransactionByHash(blocks[0].Transactions()[0].Hash())
+			Expect(err).To(MatchError(core.ErrTxNotFound))
+			Expect(p.IsPruned(blocks[1].Hash())).To(BeTrue())
+			_, err = p.GetTransactionByHash(blocks[1].Transactions()[0].Hash())
+			Expect(err).To(MatchError(core.ErrHistoryPruned))
+
+			_, err = p.GetBlockByNumber(3)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package historical
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// maxPrunedPerBlock is the maximum number of blocks that are pruned when finalizing a single
+// block, so that enabling a retention policy on a long history does not stall block production.
+const maxPrunedPerBlock = 100
+
+// defaultTombstones is the default number of pruned blocks whose hash indices are kept.
+const defaultTombstones = 100_000
+
+// RetentionPolicy defines how long the historical plugin keeps blocks, receipts, and
+// transactions. A block is pruned once it falls outside of any of the configured windows. The
+// zero value keeps all history.
+//
+// NOTE: the retention policy is node-local config, so it is only enforced on the historical data
+// stored in a node-local database. Pruning the consensus store would make the nodes diverge.
+type RetentionPolicy struct {
+	// Blocks is the number of most recent blocks to keep, 0 means no limit.
+	Blocks uint64
+	// Age is the maximum age of the blocks to keep, 0 means no limit.
+	Age time.Duration
+	// Tombstones is the number of pruned blocks, before the earliest kept block, whose block and
+	// transaction hashes are still known to be pruned. Lookups of older pruned hashes return not
+	// found errors instead of `core.ErrHistoryPruned`. 0 means `defaultTombstones`.
+	Tombstones uint64
+}
+
+// IsEnabled returns whether the retention policy prunes any history.
+func (rp RetentionPolicy) IsEnabled() bool {
+	return rp.Blocks > 0 || rp.Age > 0
+}
+
+// shouldPrune returns whether the given block is outside of the retention policy, given the
+// head block.
+func (rp RetentionPolicy) shouldPrune(block, head *coretypes.Block) bool {
+	if rp.Blocks > 0 && head.NumberU64()-block.NumberU64() >= rp.Blocks {
+		return true
+	}
+	return rp.Age > 0 && head.Time() > block.Time() &&
+		time.Duration(head.Time()-block.Time())*time.Second > rp.Age
+}
+
+// tombstones returns the number of pruned blocks whose hash indices are kept.
+func (rp RetentionPolicy) tombstones() uint64 {
+	if rp.Tombstones == 0 {
+		return defaultTombstones
+	}
+	return rp.Tombstones
+}
+
+// SetRetentionPolicy sets the retention policy that is enforced by `Prune`. It returns
+// `ErrPruneConsensusStore` if the policy prunes any history while the historical data is stored
+// in the consensus store.
+func (p *plugin) SetRetentionPolicy(rp RetentionPolicy) error {
+	if rp.IsEnabled() && p.offchainDB == nil {
+		return ErrPruneConsensusStore
+	}
+	p.retention = rp
+	return nil
+}
+
+// Prune implements `core.HistoricalPruner`. It deletes the blocks, receipts, and transactions
+// that are outside of the retention policy, starting from the earliest block. The block hash and
+// transaction hash indices are kept (the latter as tombstones) so that lookups of pruned data
+// return `core.ErrHistoryPruned`, until they are older than the tombstones of the policy.
+func (p *plugin) Prune(head *coretypes.Block) error {
+	if !p.retention.IsEnabled() {
+		return nil
+	}
+
+	start := p.EarliestBlockNumber()
+	earliest := start
+	var pruned []*coretypes.Block
+	for ; earliest < head.NumberU64() && earliest-start < maxPrunedPerBlock; earliest++ {
+		block, err := p.GetBlockByNumber(earliest)
+		if errors.Is(err, core.ErrBlockNotFound) {
+			// nothing was stored for this block, so there is nothing to prune.
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if !p.retention.shouldPrune(block, head) {
+			break
+		}
+		pruned = append(pruned, block)
+	}
+
+	// The tombstones are only read back once committed, so the pruned blocks whose tombstones
+	// would already be expired are pruned without any.
+	var expired uint64
+	if tombstones := p.retention.tombstones(); earliest > tombstones {
+		expired = earliest - tombstones
+	}
+	for _, block := range pruned {
+		p.pruneBlock(block, block.NumberU64() >= expired)
+	}
+
+	if earliest != start {
+		p.writeStore().Set([]byte{types.EarliestBlockNumKey}, sdk.Uint64ToBigEndian(earliest))
+	}
+	if expired > 0 {
+		p.expireTombstones(expired)
+	}
+	return nil
+}
+
+// pruneBlock deletes the given block along with its receipts and transactions. If tombstoned, the
+// hash indices of the block and its transactions are kept, otherwise they are deleted as well.
+func (p *plugin) pruneBlock(block *coretypes.Block, tombstoned bool) {
+	store := p.writeStore()
+	numBz := sdk.Uint64ToBigEndian(block.NumberU64())
+
+	prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Delete(numBz)
+	prefix.NewStore(store, []byte{types.BlockHashKeyToReceiptsPrefix}).Delete(block.Hash().Bytes())
+
+	txStore := prefix.NewStore(store, []byte{types.TxHashKeyToTxPrefix})
+	if !tombstoned {
+		prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).Delete(block.Hash().Bytes())
+		for _, tx := range block.Transactions() {
+			txStore.Delete(tx.Hash().Bytes())
+		}
+		return
+	}
+
+	prunedTxStore := prefix.NewStore(store, []byte{types.PrunedTxHashKeyPrefix})
+	tombstoneStore := prefix.NewStore(store, []byte{types.PrunedBlockNumKeyToHashPrefix})
+	tombstoneStore.Set(tombstoneKey(numBz, block.Hash()), []byte{})
+	for _, tx := range block.Transactions() {
+		txStore.Delete(tx.Hash().Bytes())
+		prunedTxStore.Set(tx.Hash().Bytes(), numBz)
+		tombstoneStore.Set(tombstoneKey(numBz, tx.Hash()), []byte{})
+	}
+}
+
+// expireTombstones deletes the block hash indices and transaction tombstones of the pruned blocks
+// before the given block number, at most `maxPrunedPerBlock` blocks per call.
+func (p *plugin) expireTombstones(before uint64) {
+	tombstoneStore := prefix.NewStore(p.store(), []byte{types.PrunedBlockNumKeyToHashPrefix})
+
+	// collect the expired tombstones first, as the store cannot be written while iterating.
+	var (
+		keys   [][]byte
+		blocks int
+		last   uint64
+	)
+	iter := tombstoneStore.Iterator(nil, sdk.Uint64ToBigEndian(before))
+	for ; iter.Valid(); iter.Next() {
+		if num := sdk.BigEndianToUint64(iter.Key()[:8]); blocks == 0 || num != last {
+			if blocks == maxPrunedPerBlock {
+				break
+			}
+			blocks, last = blocks+1, num
+		}
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+
+	store := p.writeStore()
+	tombstoneStore = prefix.NewStore(store, []byte{types.PrunedBlockNumKeyToHashPrefix})
+	hashStore := prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix})
+	prunedTxStore := prefix.NewStore(store, []byte{types.PrunedTxHashKeyPrefix})
+	for _, key := range keys {
+		// the hash is either the hash of a block or of one of its transactions.
+		hashStore.Delete(key[8:])
+		prunedTxStore.Delete(key[8:])
+		tombstoneStore.Delete(key)
+	}
+}
+
+// tombstoneKey returns the key of the tombstone of the given hash of the block with the given
+// number, which is ordered by block number.
+func tombstoneKey(numBz []byte, hash common.Hash) []byte {
+	key := make([]byte, 0, len(numBz)+common.HashLength)
+	return append(append(key, numBz...), hash.Bytes()...)
+}
+
+// EarliestBlockNumber implements `core.HistoricalPruner`.
+func (p *plugin) EarliestBlockNumber() uint64 {
+	return sdk.BigEndianToUint64(p.store().Get([]byte{types.EarliestBlockNumKey}))
+}
+
+// IsPruned implements `core.HistoricalPruner`.
+func (p *plugin) IsPruned(hash common.Hash) bool {
+	store := p.store()
+	if prefix.NewStore(store, []byte{types.PrunedTxHashKeyPrefix}).Has(hash.Bytes()) {
+		return true
+	}
+	numBz := prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).Get(hash.Bytes())
+	return numBz != nil && sdk.BigEndianToUint64(numBz) < p.EarliestBlockNumber()
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/params"
+)
+
+var (
+	// ErrUnknownDynamicPrecompile is returned when a dynamic precompile is not in the binary.
+	ErrUnknownDynamicPrecompile = errors.New("unknown dynamic precompile")
+	// ErrInvalidDynamicPrecompileAddress is returned when a dynamic precompile cannot be
+	// activated at an address.
+	ErrInvalidDynamicPrecompileAddress = errors.New("invalid dynamic precompile address")
+	// ErrInvalidDynamicPrecompileHeight is returned when a dynamic precompile cannot be activated
+	// or deactivated at a height.
+	ErrInvalidDynamicPrecompileHeight = errors.New("invalid dynamic precompile height")
+)
+
+// GetDynamicPrecompiles returns the dynamic precompiles that are active at the block of the given
+// number, and registers their events with the log factory at their addresses. It panics if
+// governance activated a dynamic precompile that is not in the binary, since the node cannot
+// execute the blocks in which it is active.
+//
+// GetDynamicPrecompiles implements core.DynamicPrecompileProvider.
+func (p *plugin) GetDynamicPrecompiles(
+	ctx context.Context, number uint64,
+) map[common.Address]ethprecompile.DynamicImpl {
+	active := make(map[common.Address]ethprecompile.DynamicImpl)
+	p.activeDynamic = p.activeDynamic[:0]
+	for _, dp := range p.DynamicPrecompiles(sdk.UnwrapSDKContext(ctx)) {
+		if !dp.IsActive(number) {
+			continue
+		}
+		impl, found := p.dynamic[dp.Name]
+		if !found {
+			panic(fmt.Errorf("%w: %s is active at %s", ErrUnknownDynamicPrecompile, dp.Name, dp.Address))
+		}
+		addr := common.HexToAddress(dp.Address)
+		if p.plf != nil {
+			p.plf.RegisterEvents(addr, impl)
+		}
+		active[addr] = impl
+		p.activeDynamic = append(p.activeDynamic, addr)
+	}
+	return active
+}
+
+// DynamicPrecompiles returns all the dynamic precompiles activated by governance, in order of
+// address, including the ones that are not active yet or anymore.
+func (p *plugin) DynamicPrecompiles(ctx sdk.Context) []*types.DynamicPrecompile {
+	store := prefix.NewStore(ctx.KVStore(p.storeKey), []byte{types.DynamicPrecompileKeyPrefix})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var dps []*types.DynamicPrecompile
+	for ; iter.Valid(); iter.Next() {
+		dp := new(types.DynamicPrecompile)
+		if err := dp.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		dps = append(dps, dp)
+	}
+	return dps
+}
+
+// ActivateDynamicPrecompile schedules the dynamic precompile with the given name to be activated
+// at the given address from the block of the given height. It returns an error if the precompile
+// is not in the binary, if the address is the address of another precompile, or if the height is
+// not greater than the height of the block of the given context.
+func (p *plugin) ActivateDynamicPrecompile(
+	ctx sdk.Context, name string, addr common.Address, height uint64,
+) (*types.DynamicPrecompile, error) {
+	if _, found := p.dynamic[name]; !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownDynamicPrecompile, name)
+	}
+	if height <= uint64(ctx.BlockHeight()) {
+		return nil, fmt.Errorf(
+			"%w: %d is not after the current height %d",
+			ErrInvalidDynamicPrecompileHeight, height, ctx.BlockHeight(),
+		)
+	}
+	if p.isStaticPrecompile(addr) {
+		return nil, fmt.Errorf(
+			"%w: %s is a static precompile", ErrInvalidDynamicPrecompileAddress, addr.Hex(),
+		)
+	}
+	// the address can only be reused once the previous dynamic precompile is deactivated
+	if dp := p.getDynamicPrecompile(ctx, addr); dp != nil &&
+		(dp.DeactivationHeight == 0 || dp.DeactivationHeight > uint64(ctx.BlockHeight())) {
+		return nil, fmt.Errorf(
+			"%w: %s is the address of the dynamic precompile %s",
+			ErrInvalidDynamicPrecompileAddress, addr.Hex(), dp.Name,
+		)
+	}
+
+	dp := &types.DynamicPrecompile{Name: name, Address: addr.Hex(), ActivationHeight: height}
+	p.setDynamicPrecompile(ctx, dp)
+	return dp, nil
+}
+
+// DeactivateDynamicPrecompile schedules the dynamic precompile at the given address to be
+// deactivated from the block of the given height. If the precompile is not active yet at that
+// height, its activation is cancelled instead. It returns an error if there is no dynamic
+// precompile to deactivate at the address, or if the height is not greater than the height of the
+// block of the given context.
+func (p *plugin) DeactivateDynamicPrecompile(
+	ctx sdk.Context, addr common.Address, height uint64,
+) (*types.DynamicPrecompile, error) {
+	dp := p.getDynamicPrecompile(ctx, addr)
+	if dp == nil || dp.DeactivationHeight != 0 {
+		return nil, fmt.Errorf(
+			"%w: no dynamic precompile to deactivate at %s", ErrInvalidDynamicPrecompileAddress, addr.Hex(),
+		)
+	}
+	if height <= uint64(ctx.BlockHeight()) {
+		return nil, fmt.Errorf(
+			"%w: %d is not after the current height %d",
+			ErrInvalidDynamicPrecompileHeight, height, ctx.BlockHeight(),
+		)
+	}
+
+	dp.DeactivationHeight = height
+	if height <= dp.ActivationHeight {
+		prefix.NewStore(ctx.KVStore(p.storeKey), []byte{types.DynamicPrecompileKeyPrefix}).
+			Delete(addr.Bytes())
+	} else {
+		p.setDynamicPrecompile(ctx, dp)
+	}
+	return dp, nil
+}
+
+// getDynamicPrecompile returns the dynamic precompile at the given address, or nil if there is
+// none.
+func (p *plugin) getDynamicPrecompile(
+	ctx sdk.Context, addr common.Address,
+) *types.DynamicPrecompile {
+	bz := prefix.NewStore(ctx.KVStore(p.storeKey), []byte{types.DynamicPrecompileKeyPrefix}).
+		Get(addr.Bytes())
+	if bz == nil {
+		return nil
+	}
+	dp := new(types.DynamicPrecompile)
+	if err := dp.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return dp
+}
+
+// setDynamicPrecompile stores the given dynamic precompile at its address.
+func (p *plugin) setDynamicPrecompile(ctx sdk.Context, dp *types.DynamicPrecompile) {
+	bz, err := dp.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	prefix.NewStore(ctx.KVStore(p.storeKey), []byte{types.DynamicPrecompileKeyPrefix}).
+		Set(common.HexToAddress(dp.Address).Bytes(), bz)
+}
+
+// isStaticPrecompile returns whether the given address is the address of a precompile that is
+// registered when the chain is constructed, including the default geth precompiles.
+func (p *plugin) isStaticPrecompile(addr common.Address) bool {
+	for _, pc := range p.precompiles {
+		if pc.RegistryKey() == addr {
+			return true
+		}
+	}
+	for _, pc := range ethprecompile.GetDefaultPrecompiles(&params.Rules{IsBerlin: true}) {
+		if pc.RegistryKey() == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/params"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dynamic precompiles", func() {
+	var (
+		p       *plugin
+		ctx     sdk.Context
+		dynamic = &mockDynamic{ethprecompile.NewBaseContract("[]", addr2)}
+		dynAddr = common.BytesToAddress([]byte{0x69})
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext().WithBlockHeight(10)
+		p = utils.MustGetAs[*plugin](NewPlugin(
+			[]ethprecompile.Registrable{&mockStateless{}, dynamic}, &mockSP{ctx}, testutil.EvmKey,
+		))
+	})
+
+	It("should only register the dynamic precompiles when they are activated", func() {
+		Expect(p.GetPrecompiles(nil)).To(HaveLen(1))
+		Expect(p.GetDynamicPrecompiles(ctx, 10)).To(BeEmpty())
+	})
+
+	It("should activate and deactivate a dynamic precompile", func() {
+		dp, err := p.ActivateDynamicPrecompile(ctx, "dynamic", dynAddr, 12)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dp.Address).To(Equal(dynAddr.Hex()))
+		Expect(p.DynamicPrecompiles(ctx)).To(HaveLen(1))
+
+		Expect(p.GetDynamicPrecompiles(ctx, 11)).To(BeEmpty())
+		Expect(p.GetDynamicPrecompiles(ctx, 12)).To(HaveKeyWithValue(dynAddr, dynamic))
+		Expect(p.GetActive(&params.Rules{})).To(ContainElement(dynAddr))
+
+		_, err = p.DeactivateDynamicPrecompile(ctx, dynAddr, 15)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.GetDynamicPrecompiles(ctx, 14)).To(HaveKey(dynAddr))
+		Expect(p.GetDynamicPrecompiles(ctx, 15)).To(BeEmpty())
+		Expect(p.GetActive(&params.Rules{})).ToNot(ContainElement(dynAddr))
+
+		// The address cannot be reused before the precompile is deactivated.
+		_, err = p.ActivateDynamicPrecompile(ctx, "dynamic", dynAddr, 20)
+		Expect(err).To(MatchError(ContainSubstring(ErrInvalidDynamicPrecompileAddress.Error())))
+		_, err = p.ActivateDynamicPrecompile(ctx.WithBlockHeight(15), "dynamic", dynAddr, 20)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should cancel the activation of a dynamic precompile that is not active yet", func() {
+		_, err := p.ActivateDynamicPrecompile(ctx, "dynamic", dynAddr, 12)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = p.DeactivateDynamicPrecompile(ctx, dynAddr, 11)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.DynamicPrecompiles(ctx)).To(BeEmpty())
+	})
+
+	It("should reject invalid activations and deactivations", func() {
+		_, err := p.ActivateDynamicPrecompile(ctx, "unknown", dynAddr, 12)
+		Expect(err).To(MatchError(ContainSubstring(ErrUnknownDynamicPrecompile.Error())))
+		_, err = p.ActivateDynamicPrecompile(ctx, "dynamic", dynAddr, 10)
+		Expect(err).To(MatchError(ContainSubstring(ErrInvalidDynamicPrecompileHeight.Error())))
+		_, err = p.ActivateDynamicPrecompile(ctx, "dynamic", addr, 12)
+		Expect(err).To(MatchError(ContainSubstring(ErrInvalidDynamicPrecompileAddress.Error())))
+		_, err = p.ActivateDynamicPrecompile(
+			ctx, "dynamic", common.BytesToAddress([]byte{1}), 12,
+		)
+		Expect(err).To(MatchError(ContainSubstring(ErrInvalidDynamicPrecompileAddress.Error())))
+		_, err = p.DeactivateDynamicPrecompile(ctx, dynAddr, 12)
+		Expect(err).To(MatchError(ContainSubstring(ErrInvalidDynamicPrecompileAddress.Error())))
+	})
+
+	It("should register the events of the active dynamic precompiles at their addresses", func() {
+		plf := &mockLogFactory{make(map[common.Address]ethprecompile.StatefulImpl)}
+		p.SetLogFactory(plf)
+		_, err := p.ActivateDynamicPrecompile(ctx, "dynamic", dynAddr, 12)
+		Expect(err).ToNot(HaveOccurred())
+
+		p.GetDynamicPrecompiles(ctx, 11)
+		Expect(plf.registered).To(BeEmpty())
+		p.GetDynamicPrecompiles(ctx, 12)
+		Expect(plf.registered).To(HaveKeyWithValue(dynAddr, dynamic))
+	})
+
+	It("should panic if an active dynamic precompile is not in the binary", func() {
+		_, err := p.ActivateDynamicPrecompile(ctx, "dynamic", dynAddr, 12)
+		Expect(err).ToNot(HaveOccurred())
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, &mockSP{ctx}, testutil.EvmKey))
+		Expect(func() { p.GetDynamicPrecompiles(ctx, 12) }).To(Panic())
+	})
+})
+
+type mockDynamic struct {
+	ethprecompile.BaseContract
+}
+
+func (md *mockDynamic) Name() string {
+	return "dynamic"
+}
+
+type mockLogFactory struct {
+	registered map[common.Address]ethprecompile.StatefulImpl
+}
+
+func (mlf *mockLogFactory) RegisterEvents(addr common.Address, spc ethprecompile.StatefulImpl) {
+	mlf.registered[addr] = spc
+}
@@ -20,16 +20,33 @@
 
 package precompile
 
-import storetypes "cosmossdk.io/store/types"
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	ethstate "pkg.berachain.dev/polaris/eth/core/state"
+)
 
 type (
 	StatePlugin interface {
 		SetGasConfig(storetypes.GasConfig, storetypes.GasConfig)
 	}
 
+	// StateDB is the state db of the EVM, which is built over a state plugin.
+	StateDB interface {
+		GetPlugin() ethstate.Plugin
+	}
+
 	MultiStore interface {
 		storetypes.MultiStore
 		IsReadOnly() bool
 		SetReadOnly(bool)
 	}
+
+	// LogFactory registers the events of the dynamic precompiles at the addresses at which they
+	// are activated.
+	LogFactory interface {
+		RegisterEvents(common.Address, ethprecompile.StatefulImpl)
+	}
 )
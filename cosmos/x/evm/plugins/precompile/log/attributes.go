@@ -21,6 +21,7 @@
 package log
 
 import (
+	"math/big"
 	"strconv"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -35,6 +36,7 @@ import (
 	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/lib/errors"
 )
 
 const (
@@ -83,6 +85,7 @@ var (
 	_ precompile.ValueDecoder = ConvertValAddressFromBech32
 	_ precompile.ValueDecoder = ConvertAccAddressFromBech32
 	_ precompile.ValueDecoder = ConvertInt64
+	_ precompile.ValueDecoder = ConvertBigInt
 	_ precompile.ValueDecoder = ReturnStringAsIs
 )
 
@@ -143,6 +146,18 @@ func ConvertUint64(attributeValue string) (any, error) {
 	return strconv.ParseUint(attributeValue, intBase, int64Bits)
 }
 
+// ConvertBigInt converts a `string` of a base 10 integer, such as the amount of an ICS-20
+// transfer, to a `*big.Int`.
+//
+// ConvertBigInt is a `precompile.ValueDecoder`.
+func ConvertBigInt(attributeValue string) (any, error) {
+	amount, ok := new(big.Int).SetString(attributeValue, intBase)
+	if !ok {
+		return nil, errors.Wrap(ErrInvalidInteger, attributeValue)
+	}
+	return amount, nil
+}
+
 // ReturnStringAsIs converts a given attribute of type string and returns the same string (as type
 // any).
 //
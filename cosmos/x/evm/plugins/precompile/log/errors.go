@@ -36,4 +36,7 @@ var (
 	// ErrNumberOfCoinsNotSupported is returned when the number of coins in a Cosmos event for the
 	// "amount" attribute is not equal to 1.
 	ErrNumberOfCoinsNotSupported = errors.New("number of coins not supported")
+	// ErrInvalidInteger is returned when a Cosmos event's attribute value is not a base 10
+	// integer.
+	ErrInvalidInteger = errors.New("invalid integer attribute value")
 )
@@ -24,6 +24,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events"
+	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/precompile"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/lib/registry"
@@ -31,6 +32,15 @@ import (
 	"pkg.berachain.dev/polaris/lib/utils"
 )
 
+// EventValueDecodersProvider is implemented by the precompiles whose events have attributes that
+// must be decoded differently than the attributes with the same keys in the events of other
+// modules.
+type EventValueDecodersProvider interface {
+	// EventValueDecoders returns a map of Cosmos event types to the value decoder functions of
+	// their attributes, which take precedence over the custom and default value decoders.
+	EventValueDecoders() map[string]precompile.ValueDecoders
+}
+
 // Factory is a `PrecompileLogFactory` that builds Ethereum logs from Cosmos events. All Ethereum
 // events must be registered with the factory before it can build logs during state transitions.
 type Factory struct {
@@ -39,6 +49,9 @@ type Factory struct {
 	// customValueDecoders is a map of Cosmos attribute keys to attribute value decoder
 	// functions for custom events.
 	customValueDecoders precompile.ValueDecoders
+	// eventValueDecoders is a map of Cosmos event types to attribute value decoder functions for
+	// the attributes of only that event.
+	eventValueDecoders map[string]precompile.ValueDecoders
 }
 
 // NewFactory returns a `Factory` with the events and custom value decoders of the given
@@ -47,6 +60,7 @@ func NewFactory(precompiles []precompile.Registrable) *Factory {
 	f := &Factory{
 		events:              registry.NewMap[string, *precompileLog](),
 		customValueDecoders: make(precompile.ValueDecoders),
+		eventValueDecoders:  make(map[string]precompile.ValueDecoders),
 	}
 	f.registerAllEvents(precompiles)
 	return f
@@ -83,20 +97,38 @@ func (f *Factory) Build(event *sdk.Event) (*coretypes.Log, error) {
 	return log, nil
 }
 
+// RegisterEvents registers the Ethereum events of the given precompile with the factory, as logs
+// of the given address. It is used to register the events of a dynamic precompile at the address
+// at which governance activated it.
+func (f *Factory) RegisterEvents(addr common.Address, spc precompile.StatefulImpl) {
+	// register the ABI Event as a precompile log
+	for _, event := range spc.ABIEvents() {
+		_ = f.events.Register(newPrecompileLog(addr, event))
+	}
+
+	// register the precompile's custom value decoders, if any are provided
+	for attr, decoder := range spc.CustomValueDecoders() {
+		f.customValueDecoders[attr] = decoder
+	}
+
+	// register the precompile's event value decoders, if any are provided
+	if evp, ok := spc.(EventValueDecodersProvider); ok {
+		for eventType, decoders := range evp.EventValueDecoders() {
+			f.eventValueDecoders[eventType] = decoders
+		}
+	}
+}
+
 // registerAllEvents registers all Ethereum events from the provided precompiles with the factory.
+// The events of the dynamic precompiles are only registered once they are activated, since their
+// logs must have the address chosen by governance.
 func (f *Factory) registerAllEvents(precompiles []precompile.Registrable) {
 	for _, pc := range precompiles {
+		if utils.Implements[precompile.DynamicImpl](pc) {
+			continue
+		}
 		if spc, ok := utils.GetAs[precompile.StatefulImpl](pc); ok {
-			// register the ABI Event as a precompile log
-			moduleEthAddr := spc.RegistryKey()
-			for _, event := range spc.ABIEvents() {
-				_ = f.events.Register(newPrecompileLog(moduleEthAddr, event))
-			}
-
-			// register the precompile's custom value decoders, if any are provided
-			for attr, decoder := range spc.CustomValueDecoders() {
-				f.customValueDecoders[attr] = decoder
-			}
+			f.RegisterEvents(spc.RegistryKey(), spc)
 		}
 	}
 }
@@ -122,6 +122,25 @@ var _ = Describe("Factory", func() {
 			Expect(log.Data).To(Equal(packedData))
 		})
 
+		It("should build the logs of a dynamic precompile at the address it is activated at", func() {
+			dynamic := &mockDynamicImpl{pc}
+			f = NewFactory([]precompile.Registrable{dynamic})
+			event := sdk.NewEvent(
+				"cancel_unbonding_delegation",
+				sdk.NewAttribute("validator", valAddr.String()),
+				sdk.NewAttribute("amount", amt.String()),
+				sdk.NewAttribute("creation_height", strconv.FormatInt(creationHeight, 10)),
+				sdk.NewAttribute("delegator", delAddr.String()),
+			)
+			_, err := f.Build(&event)
+			Expect(err).To(HaveOccurred())
+
+			f.RegisterEvents(common.BytesToAddress([]byte{0x69}), dynamic)
+			log, err := f.Build(&event)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(log.Address).To(Equal(common.BytesToAddress([]byte{0x69})))
+		})
+
 		It("should correctly build a log for valid event with custom decoder", func() {
 			pc.RegistryKeyFunc = func() common.Address {
 				return common.BytesToAddress([]byte{0x02})
@@ -255,6 +274,14 @@ var _ = Describe("Factory", func() {
 
 // MOCKS BELOW.
 
+type mockDynamicImpl struct {
+	*mock.StatefulImplMock
+}
+
+func (mdi *mockDynamicImpl) Name() string {
+	return "dynamic"
+}
+
 func mockCustomAbiEvent() map[string]abi.Event {
 	addrType, _ := abi.NewType("address", "address", nil)
 	coinType, _ := abi.NewType("tuple[]", "structIStakingModule.Coin[]", []abi.ArgumentMarshaling{
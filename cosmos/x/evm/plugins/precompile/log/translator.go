@@ -51,7 +51,7 @@ func (f *Factory) makeTopics(pl *precompileLog, event *sdk.Event) ([]common.Hash
 
 		// convert attribute value (string) to geth compatible type
 		attr := &event.Attributes[attrIdx]
-		decode, err := f.getValueDecoder(attr.Key)
+		decode, err := f.getValueDecoder(event.Type, attr.Key)
 		if err != nil {
 			return nil, err
 		}
@@ -88,7 +88,7 @@ func (f *Factory) makeData(pl *precompileLog, event *sdk.Event) ([]byte, error)
 
 		// convert attribute value (string) to geth compatible type
 		attr := event.Attributes[attrIdx]
-		decode, err := f.getValueDecoder(attr.Key)
+		decode, err := f.getValueDecoder(event.Type, attr.Key)
 		if err != nil {
 			return nil, err
 		}
@@ -108,8 +108,13 @@ func (f *Factory) makeData(pl *precompileLog, event *sdk.Event) ([]byte, error)
 }
 
 // getValueDecoder returns an attribute value decoder function for a certain Cosmos event
-// attribute key.
-func (f *Factory) getValueDecoder(attrKey string) (precompile.ValueDecoder, error) {
+// attribute key of an event of the given type.
+func (f *Factory) getValueDecoder(eventType, attrKey string) (precompile.ValueDecoder, error) {
+	// try event specific precompile event attributes
+	if eventDecoder, found := f.eventValueDecoders[eventType][attrKey]; found {
+		return eventDecoder, nil
+	}
+
 	// try custom precompile event attributes
 	if customDecoder, found := f.customValueDecoders[attrKey]; found {
 		return customDecoder, nil
@@ -29,6 +29,7 @@ import (
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
@@ -42,36 +43,66 @@ import (
 // Plugin is the interface that must be implemented by the plugin.
 type Plugin interface {
 	plugins.Base
+	plugins.HasModuleGenesis
 	core.PrecompilePlugin
 
+	core.DynamicPrecompileProvider
+
 	KVGasConfig() storetypes.GasConfig
 	SetKVGasConfig(storetypes.GasConfig)
 	TransientKVGasConfig() storetypes.GasConfig
 	SetTransientKVGasConfig(storetypes.GasConfig)
+	SetLogFactory(LogFactory)
+	DynamicPrecompiles(sdk.Context) []*types.DynamicPrecompile
+	ActivateDynamicPrecompile(
+		sdk.Context, string, common.Address, uint64,
+	) (*types.DynamicPrecompile, error)
+	DeactivateDynamicPrecompile(sdk.Context, common.Address, uint64) (*types.DynamicPrecompile, error)
 }
 
 // plugin runs precompile containers in the Cosmos environment with the context gas configs.
 type plugin struct {
 	libtypes.Registry[common.Address, vm.PrecompileContainer]
-	// precompiles is all supported precompile contracts.
+	// precompiles is all supported precompile contracts, except the dynamic ones.
 	precompiles []ethprecompile.Registrable
+	// dynamic is the dynamic precompile contracts that governance can activate, indexed by name.
+	dynamic map[string]ethprecompile.DynamicImpl
+	// activeDynamic is the addresses of the dynamic precompiles active at the current block.
+	activeDynamic []common.Address
+	// plf registers the events of the dynamic precompiles when they are activated.
+	plf LogFactory
+	// storeKey is the key of the store of the dynamic precompiles activated by governance.
+	storeKey storetypes.StoreKey
 	// kvGasConfig is the gas config for the KV store.
 	kvGasConfig storetypes.GasConfig
 	// transientKVGasConfig is the gas config for the transient KV store.
 	transientKVGasConfig storetypes.GasConfig
-	// sp allows resetting the context for the reentrancy into the EVM.
+	// sp allows resetting the context for the reentrancy into the EVM, if the state db of the EVM
+	// does not expose its own state plugin.
 	sp StatePlugin
 }
 
-// NewPlugin creates and returns a plugin with the default KV store gas configs.
-func NewPlugin(precompiles []ethprecompile.Registrable, sp StatePlugin) Plugin {
-	return &plugin{
+// NewPlugin creates and returns a plugin with the default KV store gas configs. The given dynamic
+// precompiles (`DynamicImpl`) are only registered when governance activates them.
+func NewPlugin(
+	precompiles []ethprecompile.Registrable, sp StatePlugin, storeKey storetypes.StoreKey,
+) Plugin {
+	p := &plugin{
 		Registry:             registry.NewMap[common.Address, vm.PrecompileContainer](),
-		precompiles:          precompiles,
+		dynamic:              make(map[string]ethprecompile.DynamicImpl),
+		storeKey:             storeKey,
 		kvGasConfig:          storetypes.KVGasConfig(),
 		transientKVGasConfig: storetypes.TransientGasConfig(),
 		sp:                   sp,
 	}
+	for _, pc := range precompiles {
+		if dpc, ok := utils.GetAs[ethprecompile.DynamicImpl](pc); ok {
+			p.dynamic[dpc.Name()] = dpc
+		} else {
+			p.precompiles = append(p.precompiles, pc)
+		}
+	}
+	return p
 }
 
 // GetPrecompiles implements core.PrecompilePlugin.
@@ -82,14 +113,16 @@ func (p *plugin) GetPrecompiles(_ *params.Rules) []ethprecompile.Registrable {
 // GetActive implements core.PrecompilePlugin.
 func (p *plugin) GetActive(rules *params.Rules) []common.Address {
 	defaults := ethprecompile.GetDefaultPrecompiles(rules)
-	active := make([]common.Address, len(p.precompiles)+len(defaults))
-	for i, pc := range p.precompiles {
-		active[i] = pc.RegistryKey()
+	active := make(
+		[]common.Address, 0, len(p.precompiles)+len(defaults)+len(p.activeDynamic),
+	)
+	for _, pc := range p.precompiles {
+		active = append(active, pc.RegistryKey())
 	}
-	for i, pc := range defaults {
-		active[i+len(p.precompiles)] = pc.RegistryKey()
+	for _, pc := range defaults {
+		active = append(active, pc.RegistryKey())
 	}
-	return active
+	return append(active, p.activeDynamic...)
 }
 
 // KVGasConfig implements Plugin.
@@ -112,6 +145,14 @@ func (p *plugin) SetTransientKVGasConfig(transientKVGasConfig storetypes.GasConf
 	p.transientKVGasConfig = transientKVGasConfig
 }
 
+// SetLogFactory sets the factory with which the events of the dynamic precompiles are registered,
+// at the addresses at which they are activated.
+//
+// SetLogFactory implements Plugin.
+func (p *plugin) SetLogFactory(plf LogFactory) {
+	p.plf = plf
+}
+
 // Run runs the a precompile container and returns the remaining gas after execution by injecting
 // a Cosmos SDK `GasMeter`. This function returns an error if the precompile execution returns an
 // error or insufficient gas is provided.
@@ -181,7 +222,7 @@ func (p *plugin) enableReentrancy(sdb vm.PolarisStateDB) {
 	cem.EndPrecompileExecution()
 
 	// remove Cosmos gas consumption so gas is consumed only per OPCODE
-	p.sp.SetGasConfig(storetypes.GasConfig{}, storetypes.GasConfig{})
+	p.statePlugin(sdb).SetGasConfig(storetypes.GasConfig{}, storetypes.GasConfig{})
 }
 
 // DisableReentrancy sets the state so that execution cannot enter the EVM again.
@@ -199,7 +240,19 @@ func (p *plugin) disableReentrancy(sdb vm.PolarisStateDB) {
 	cem.BeginPrecompileExecution(sdb)
 
 	// restore ctx gas configs for continuing precompile execution
-	p.sp.SetGasConfig(p.kvGasConfig, p.transientKVGasConfig)
+	p.statePlugin(sdb).SetGasConfig(p.kvGasConfig, p.transientKVGasConfig)
+}
+
+// statePlugin returns the state plugin that the given state db is built over, which is not the
+// state plugin of the chain when the EVM runs on another state (e.g. in a simulation of the
+// pending block).
+func (p *plugin) statePlugin(sdb vm.PolarisStateDB) StatePlugin {
+	if s, ok := utils.GetAs[StateDB](sdb); ok {
+		if sp, ok := utils.GetAs[StatePlugin](s.GetPlugin()); ok {
+			return sp
+		}
+	}
+	return p.sp
 }
 
 func (p *plugin) IsPlugin() {}
@@ -34,6 +34,7 @@ import (
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events/mock"
 	"pkg.berachain.dev/polaris/eth/common"
+	ethstate "pkg.berachain.dev/polaris/eth/core/state"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/eth/core/vm"
 	"pkg.berachain.dev/polaris/lib/utils"
@@ -52,7 +53,7 @@ var _ = Describe("plugin", func() {
 		ctx = ctx.WithEventManager(
 			events.NewManagerFrom(ctx.EventManager(), mock.NewPrecompileLogFactory()),
 		)
-		p = utils.MustGetAs[*plugin](NewPlugin(nil, &mockSP{ctx}))
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, &mockSP{ctx}, testutil.EvmKey))
 		e = &mockEVM{nil, ctx, &mockSDB{nil, ctx, 0}}
 	})
 
@@ -81,6 +82,17 @@ var _ = Describe("plugin", func() {
 		Expect(p.TransientKVGasConfig().DeleteCost).To(Equal(uint64(3)))
 	})
 
+	It("should switch the gas configs of the state plugin of the state db", func() {
+		sp := &mockSP{ctx}
+		e = &mockEVM{nil, ctx, &mockPluginSDB{&mockSDB{nil, ctx, 0}, &mockStatePlugin{mockSP: sp}}}
+		_, _, err := p.Run(e, &mockStateless{}, []byte{}, addr, new(big.Int), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		// the gas configs are only removed from the state plugin of the state db
+		Expect(sp.ctx.KVGasConfig()).To(Equal(storetypes.GasConfig{}))
+		Expect(utils.MustGetAs[*mockSP](p.sp).ctx.KVGasConfig()).To(Equal(ctx.KVGasConfig()))
+	})
+
 	It("should handle read-only static calls", func() {
 		ms := utils.MustGetAs[tmock.MultiStore](ctx.MultiStore())
 		cem := utils.MustGetAs[state.ControllableEventManager](ctx.EventManager())
@@ -118,7 +130,7 @@ func (msp *mockSP) SetGasConfig(kvg storetypes.GasConfig, tkvg storetypes.GasCon
 type mockEVM struct {
 	vm.PrecompileEVM
 	ctx sdk.Context
-	ms  *mockSDB
+	ms  vm.PolarisStateDB
 }
 
 func (me *mockEVM) GetStateDB() vm.GethStateDB {
@@ -139,6 +151,20 @@ func (ms *mockSDB) AddLog(*coretypes.Log) {
 	ms.logs++
 }
 
+type mockStatePlugin struct {
+	ethstate.Plugin
+	*mockSP
+}
+
+type mockPluginSDB struct {
+	*mockSDB
+	sp *mockStatePlugin
+}
+
+func (ms *mockPluginSDB) GetPlugin() ethstate.Plugin {
+	return ms.sp
+}
+
 type mockStateless struct{} // at addr 1
 
 func (ms *mockStateless) RegistryKey() common.Address {
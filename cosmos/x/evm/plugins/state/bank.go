@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// evmDecimals is the number of decimals of the EVM native balance (wei).
+const evmDecimals = 18
+
+// SetBankBalances implements `Plugin` by backing the EVM native balance by the given bank denom,
+// which has the given number of decimals. A balance of 1 unit of the denom is a balance of
+// 10^(18-decimals) wei in the EVM. The remainder of a balance that cannot be represented in units
+// of the denom is kept in the evm store. As this changes the state, it must be the same on every
+// node of the network.
+func (p *plugin) SetBankBalances(bk BankKeeper, denom string, decimals uint8) {
+	if decimals > evmDecimals {
+		panic(fmt.Sprintf("denom %s has more than %d decimals", denom, evmDecimals))
+	}
+	p.bk = bk
+	p.denom = denom
+	p.decimals = decimals
+	p.scale = new(big.Int).Exp(
+		big.NewInt(10), big.NewInt(int64(evmDecimals-decimals)), nil, //nolint:gomnd // 10.
+	)
+}
+
+// getBankBalance returns the balance of the given address in wei, which is the balance of the
+// bank denom scaled to wei plus the remainder kept in the evm store.
+func (p *plugin) getBankBalance(addr common.Address) *big.Int {
+	coin := p.bk.GetBalance(p.ctx, addr[:], p.denom)
+	balance := new(big.Int).Mul(coin.Amount.BigInt(), p.scale)
+	return balance.Add(balance, p.getRemainder(addr))
+}
+
+// setBankBalance sets the balance of the given address in wei by minting or burning the difference
+// in units of the bank denom through the evm module account, and keeping the remainder in the evm
+// store. As the bank keeper writes to the context of the plugin, these changes are snapshotted
+// and reverted along with the rest of the EVM state. A transfer between two accounts is therefore
+// a burn from the sender and a mint to the recipient, which keeps the supply unchanged. Setting
+// the balance of an address that is blocked by the bank keeper (e.g. a module account) fails.
+func (p *plugin) setBankBalance(addr common.Address, amount *big.Int) {
+	units, remainder := new(big.Int).QuoRem(amount, p.scale, new(big.Int))
+	if units.Sign() < 0 || remainder.Sign() < 0 {
+		p.savedErr = fmt.Errorf("cannot set negative balance %s for %s", amount, addr)
+		return
+	}
+
+	// The balance changes of the EVM are not Cosmos events, so the events emitted by the bank
+	// keeper are discarded (and are never converted to Eth logs during a precompile execution).
+	ctx := p.ctx.WithEventManager(sdk.NewEventManager())
+	diff := new(big.Int).Sub(units, p.bk.GetBalance(ctx, addr[:], p.denom).Amount.BigInt())
+	switch diff.Sign() {
+	case 1:
+		coins := sdk.NewCoins(sdk.NewCoin(p.denom, sdkmath.NewIntFromBigInt(diff)))
+		if err := p.bk.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			p.savedErr = err
+			return
+		}
+		if err := p.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr[:], coins); err != nil {
+			p.savedErr = err
+			return
+		}
+	case -1:
+		coins := sdk.NewCoins(sdk.NewCoin(p.denom, sdkmath.NewIntFromBigInt(diff.Neg(diff))))
+		if err := p.bk.SendCoinsFromAccountToModule(ctx, addr[:], types.ModuleName, coins); err != nil {
+			p.savedErr = err
+			return
+		}
+		if err := p.bk.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			p.savedErr = err
+			return
+		}
+	}
+
+	p.setRemainder(addr, remainder)
+}
+
+// iterateBankBalances iterates over the balances in wei of all accounts that hold the bank denom
+// or a remainder in the evm store.
+func (p *plugin) iterateBankBalances(fn func(common.Address, *big.Int) bool) {
+	seen := make(map[common.Address]struct{})
+	stop := false
+	p.bk.IterateAllBalances(p.ctx, func(accAddr sdk.AccAddress, coin sdk.Coin) bool {
+		if coin.Denom != p.denom {
+			return false
+		}
+		addr := common.BytesToAddress(accAddr)
+		seen[addr] = struct{}{}
+		balance := new(big.Int).Mul(coin.Amount.BigInt(), p.scale)
+		stop = fn(addr, balance.Add(balance, p.getRemainder(addr)))
+		return stop
+	})
+	if stop {
+		return
+	}
+
+	// Iterate over the accounts that only hold a remainder.
+	it := storetypes.KVStorePrefixIterator(
+		p.cms.GetKVStore(p.storeKey),
+		[]byte{types.BalanceKeyPrefix},
+	)
+	defer func() {
+		if err := it.Close(); err != nil {
+			p.savedErr = err
+		}
+	}()
+
+	for ; it.Valid(); it.Next() {
+		addr := AddressFromBalanceKey(it.Key())
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		if fn(addr, p.getRemainder(addr)) {
+			break
+		}
+	}
+}
+
+// getRemainder returns the remainder of the balance of the given address, which is kept in the
+// evm store.
+func (p *plugin) getRemainder(addr common.Address) *big.Int {
+	return new(big.Int).SetBytes(p.ctx.KVStore(p.storeKey).Get(BalanceKeyFor(addr)))
+}
+
+// setRemainder sets the remainder of the balance of the given address in the evm store.
+func (p *plugin) setRemainder(addr common.Address, remainder *big.Int) {
+	if remainder.Sign() == 0 {
+		p.ctx.KVStore(p.storeKey).Delete(BalanceKeyFor(addr))
+		return
+	}
+	p.ctx.KVStore(p.storeKey).Set(BalanceKeyFor(addr), remainder.Bytes())
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bank Balances", func() {
+	const denom = "abera"
+
+	var ctx sdk.Context
+	var bk bankkeeper.BaseKeeper
+	var sp state.Plugin
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers()
+		sp = state.NewPlugin(ak, testutil.EvmKey, &mockPLF{})
+	})
+
+	bankBalance := func(addr common.Address) sdkmath.Int {
+		return bk.GetBalance(ctx, addr[:], denom).Amount
+	}
+
+	When("the denom has 18 decimals", func() {
+		BeforeEach(func() {
+			sp.SetBankBalances(bk, denom, 18)
+			sp.Reset(ctx)
+		})
+
+		It("should mint and burn the balance in the bank", func() {
+			sp.AddBalance(alice, big.NewInt(100))
+			sp.SubBalance(alice, big.NewInt(30))
+			Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(70)))
+			Expect(sp.Error()).ToNot(HaveOccurred())
+
+			sp.Finalize()
+			Expect(bankBalance(alice)).To(Equal(sdkmath.NewInt(70)))
+			Expect(bk.GetSupply(ctx, denom).Amount).To(Equal(sdkmath.NewInt(70)))
+		})
+
+		It("should read balances set in the bank", func() {
+			coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(50)))
+			Expect(bk.MintCoins(ctx, "evm", coins)).To(Succeed())
+			Expect(bk.SendCoinsFromModuleToAccount(ctx, "evm", bob[:], coins)).To(Succeed())
+			sp.Reset(ctx)
+			Expect(sp.GetBalance(bob)).To(Equal(big.NewInt(50)))
+		})
+
+		It("should revert the bank balance", func() {
+			sp.AddBalance(alice, big.NewInt(100))
+			revision := sp.Snapshot()
+			sp.SubBalance(alice, big.NewInt(100))
+			sp.AddBalance(bob, big.NewInt(100))
+			Expect(sp.GetBalance(alice)).To(Equal(new(big.Int)))
+			sp.RevertToSnapshot(revision)
+			Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(100)))
+			Expect(sp.GetBalance(bob)).To(Equal(new(big.Int)))
+
+			sp.Finalize()
+			Expect(bankBalance(alice)).To(Equal(sdkmath.NewInt(100)))
+			Expect(bankBalance(bob)).To(Equal(sdkmath.ZeroInt()))
+		})
+
+		It("should transfer by burning from the sender and minting to the recipient", func() {
+			sp.AddBalance(alice, big.NewInt(100))
+			sp.Finalize()
+			sp.Reset(ctx)
+
+			sp.SubBalance(alice, big.NewInt(40))
+			sp.AddBalance(bob, big.NewInt(40))
+			Expect(sp.Error()).ToNot(HaveOccurred())
+			sp.Finalize()
+
+			Expect(bankBalance(alice)).To(Equal(sdkmath.NewInt(60)))
+			Expect(bankBalance(bob)).To(Equal(sdkmath.NewInt(40)))
+			Expect(bk.GetSupply(ctx, denom).Amount).To(Equal(sdkmath.NewInt(100)))
+			evmModule := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+			Expect(bankBalance(evmModule)).To(Equal(sdkmath.ZeroInt()))
+		})
+
+		It("should not support account proofs", func() {
+			_, err := sp.GetProof(alice)
+			Expect(err).To(MatchError(state.ErrBankBalanceProof))
+		})
+
+		It("should fail to set a negative balance", func() {
+			sp.SubBalance(alice, big.NewInt(1))
+			Expect(sp.Error()).To(HaveOccurred())
+		})
+
+		It("should iterate over the balances", func() {
+			sp.AddBalance(alice, big.NewInt(10))
+			sp.AddBalance(bob, big.NewInt(20))
+			balances := make(map[common.Address]*big.Int)
+			sp.IterateBalances(func(addr common.Address, balance *big.Int) bool {
+				balances[addr] = balance
+				return false
+			})
+			Expect(balances).To(HaveLen(2))
+			Expect(balances[alice]).To(Equal(big.NewInt(10)))
+			Expect(balances[bob]).To(Equal(big.NewInt(20)))
+		})
+	})
+
+	When("the denom has 6 decimals", func() {
+		BeforeEach(func() {
+			sp.SetBankBalances(bk, denom, 6)
+			sp.Reset(ctx)
+		})
+
+		It("should scale the balance and keep the remainder", func() {
+			// 2.5 units of the denom and 7 wei.
+			sp.AddBalance(alice, big.NewInt(2_500_000_000_007))
+			Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(2_500_000_000_007)))
+
+			sp.SubBalance(alice, big.NewInt(500_000_000_008))
+			Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(1_999_999_999_999)))
+			Expect(sp.Error()).ToNot(HaveOccurred())
+
+			sp.Finalize()
+			Expect(bankBalance(alice)).To(Equal(sdkmath.NewInt(1)))
+			sp.Reset(ctx)
+			Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(1_999_999_999_999)))
+		})
+
+		It("should iterate over accounts that only hold a remainder", func() {
+			sp.AddBalance(bob, big.NewInt(42))
+			var balance *big.Int
+			sp.IterateBalances(func(addr common.Address, b *big.Int) bool {
+				Expect(addr).To(Equal(bob))
+				balance = b
+				return false
+			})
+			Expect(balance).To(Equal(big.NewInt(42)))
+		})
+	})
+
+	When("the recipient is blocked by the bank keeper", func() {
+		var blocked common.Address
+
+		BeforeEach(func() {
+			blocked = common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName))
+			_, ak, _, _ := testutil.SetupMinimalKeepers()
+			bk = bankkeeper.NewBaseKeeper(
+				testutil.GetEncodingConfig().Codec,
+				runtime.NewKVStoreService(testutil.BankKey),
+				ak,
+				map[string]bool{sdk.AccAddress(blocked[:]).String(): true},
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				log.NewNopLogger(),
+			)
+			sp.SetBankBalances(bk, denom, 18)
+			sp.Reset(ctx)
+		})
+
+		It("should fail to send to the blocked address", func() {
+			sp.AddBalance(alice, big.NewInt(100))
+			Expect(sp.Error()).ToNot(HaveOccurred())
+			sp.AddBalance(blocked, big.NewInt(100))
+			Expect(sp.Error()).To(HaveOccurred())
+			Expect(bankBalance(blocked)).To(Equal(sdkmath.ZeroInt()))
+		})
+	})
+
+	It("should panic on a denom with more than 18 decimals", func() {
+		Expect(func() { sp.SetBankBalances(bk, denom, 19) }).To(Panic())
+	})
+})
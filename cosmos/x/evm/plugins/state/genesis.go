@@ -34,12 +34,19 @@ import (
 func (p *plugin) InitGenesis(ctx sdk.Context, ethGen *core.Genesis) {
 	p.Reset(ctx)
 
-	// Iterate over the genesis accounts and set the balances.
+	// Iterate over the genesis accounts and set the balances, nonces, codes, and storage.
 	for address, account := range ethGen.Alloc {
-		// TODO: technically wrong since its overriding / hacking the auth keeper and
-		// we are using the nonce from the account keeper as well.
-		p.CreateAccount(address)
+		// The accounts that are already initialized by the auth genesis are not overridden, so
+		// that their account number and public key are kept.
+		if !p.Exist(address) {
+			p.CreateAccount(address)
+		}
+		// If the balances are backed by a bank denom, only the difference with the balance
+		// already initialized by the bank genesis is minted.
 		p.SetBalance(address, account.Balance)
+		if account.Nonce != 0 {
+			p.SetNonce(address, account.Nonce)
+		}
 		if account.Code != nil {
 			p.SetCode(address, account.Code)
 		}
@@ -52,36 +59,57 @@ func (p *plugin) InitGenesis(ctx sdk.Context, ethGen *core.Genesis) {
 	p.Finalize()
 }
 
-// Export genesis modifies a pointer to a genesis state object and populates it.
+// Export genesis modifies a pointer to a genesis state object and populates it. Every account that
+// has a balance, a nonce, code, or storage is exported, so that importing the exported genesis
+// results in the same state.
 func (p *plugin) ExportGenesis(ctx sdk.Context, ethGen *core.Genesis) {
 	p.Reset(ctx)
 	ethGen.Alloc = make(core.GenesisAlloc)
 
-	// Iterate Balances and set the genesis accounts.
-	p.IterateBalances(func(address common.Address, balance *big.Int) bool {
-		account, ok := ethGen.Alloc[address]
-		if !ok {
-			account = core.GenesisAccount{}
+	// Collect the addresses of the accounts to export.
+	addresses := make(map[common.Address]struct{})
+	p.IterateBalances(func(address common.Address, _ *big.Int) bool {
+		addresses[address] = struct{}{}
+		return false
+	})
+	p.ak.IterateAccounts(p.ctx, func(account sdk.AccountI) bool {
+		if addr := account.GetAddress(); len(addr) == common.AddressLength && account.GetSequence() != 0 {
+			addresses[common.BytesToAddress(addr)] = struct{}{}
+		}
+		return false
+	})
+	p.iterateCodeHashes(func(address common.Address) bool {
+		if p.GetCode(address) != nil {
+			addresses[address] = struct{}{}
+		}
+		return false
+	})
+	p.IterateState(func(address common.Address, _ common.Hash, _ common.Hash) bool {
+		addresses[address] = struct{}{}
+		return false
+	})
+
+	// Set the balance, nonce, and code of the genesis accounts.
+	for address := range addresses {
+		account := core.GenesisAccount{
+			Balance: p.GetBalance(address),
+			Nonce:   p.GetNonce(address),
+			Code:    p.GetCode(address),
 		}
-		account.Code = p.GetCode(address)
 		if account.Code != nil {
 			account.Storage = make(map[common.Hash]common.Hash)
 		}
-		account.Balance = p.GetBalance(address)
 		ethGen.Alloc[address] = account
-		return false
-	})
+	}
 
-	// Iterate Storage and set the genesis accounts.
+	// Iterate Storage and set the storage of the genesis accounts.
 	p.IterateState(func(address common.Address, key common.Hash, value common.Hash) bool {
-		account, ok := ethGen.Alloc[address]
-		if !ok {
-			account = core.GenesisAccount{}
-		}
+		account := ethGen.Alloc[address]
 		if account.Storage == nil {
 			account.Storage = make(map[common.Hash]common.Hash)
 		}
 		account.Storage[key] = value
+		ethGen.Alloc[address] = account
 		return false
 	})
 }
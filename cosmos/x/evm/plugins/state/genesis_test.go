@@ -21,6 +21,7 @@
 package state_test
 
 import (
+	"encoding/json"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -83,4 +84,43 @@ var _ = Describe("Genesis", func() {
 		sp.ExportGenesis(ctx, &exportedGenesis)
 		Expect(exportedGenesis.Alloc).To(Equal(genesis.Alloc))
 	})
+
+	It("should export and import genesis losslessly", func() {
+		genesis := new(core.Genesis)
+		genesis.Alloc = core.GenesisAlloc{
+			// An account with a balance and a nonce.
+			alice: {Balance: big.NewInt(5e18), Nonce: 3},
+			// An account with only a nonce.
+			bob: {Balance: new(big.Int), Nonce: 7},
+			// A contract with no balance.
+			common.HexToAddress("0x1234"): {
+				Balance: new(big.Int),
+				Nonce:   1,
+				Code:    code,
+				Storage: map[common.Hash]common.Hash{
+					common.BytesToHash([]byte("key")): common.BytesToHash([]byte("value")),
+				},
+			},
+		}
+		sp.InitGenesis(ctx, genesis)
+
+		var exported core.Genesis
+		sp.ExportGenesis(ctx, &exported)
+		genesisJSON, err := json.Marshal(genesis.Alloc)
+		Expect(err).ToNot(HaveOccurred())
+		exportedJSON, err := json.Marshal(exported.Alloc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exportedJSON).To(MatchJSON(genesisJSON))
+
+		// Import the exported genesis on a new chain and export it again.
+		newCtx, ak, _, _ := testutil.SetupMinimalKeepers()
+		newSp := state.NewPlugin(ak, testutil.EvmKey, nil)
+		newSp.InitGenesis(newCtx, &exported)
+
+		var reexported core.Genesis
+		newSp.ExportGenesis(newCtx, &reexported)
+		reexportedJSON, err := json.Marshal(reexported.Alloc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reexportedJSON).To(Equal(exportedJSON))
+	})
 })
@@ -70,3 +70,17 @@ type AccountKeeper interface {
 	RemoveAccount(ctx context.Context, account sdk.AccountI)
 	IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) bool)
 }
+
+// BankKeeper defines the expected bank keeper, used to back the EVM native balance by a bank denom.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	IterateAllBalances(ctx context.Context, cb func(addr sdk.AccAddress, coin sdk.Coin) bool)
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(
+		ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+	) error
+	SendCoinsFromAccountToModule(
+		ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+	) error
+}
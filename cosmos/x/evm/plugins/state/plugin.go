@@ -26,6 +26,8 @@ import (
 	"math/big"
 	"sync"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,6 +38,7 @@ import (
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/precompile"
 	ethstate "pkg.berachain.dev/polaris/eth/core/state"
 	"pkg.berachain.dev/polaris/eth/crypto"
 	"pkg.berachain.dev/polaris/lib/snapshot"
@@ -56,14 +59,20 @@ type Plugin interface {
 	plugins.Base
 	plugins.HasGenesis
 	core.StatePlugin
+	ethstate.ProvablePlugin
 	// SetQueryContextFn sets the query context func for the plugin.
 	SetQueryContextFn(fn func(height int64, prove bool) (sdk.Context, error))
+	// SetABCIQueryFn sets the ABCI query func for the plugin, used to build proofs of state.
+	SetABCIQueryFn(fn func(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error))
 	// IterateBalances iterates over the balances of all accounts and calls the given callback function.
 	IterateBalances(fn func(common.Address, *big.Int) bool)
 	// IterateState iterates over the state of all accounts and calls the given callback function.
 	IterateState(fn func(addr common.Address, key common.Hash, value common.Hash) bool)
 	// SetGasConfig sets the gas config for the plugin.
 	SetGasConfig(storetypes.GasConfig, storetypes.GasConfig)
+	// SetBankBalances backs the EVM native balance by the given bank denom with the given number
+	// of decimals.
+	SetBankBalances(bk BankKeeper, denom string, decimals uint8)
 }
 
 // The StatePlugin is a very fun and interesting part of the EVM implementation. But if you want to
@@ -105,10 +114,20 @@ type plugin struct {
 
 	// keepers used for balance and account information.
 	ak AccountKeeper
+	bk BankKeeper
+
+	// denom, decimals, and scale configure the bank denom backing the EVM native balance, if bk
+	// is set. A unit of the denom is scale wei.
+	denom    string
+	decimals uint8
+	scale    *big.Int
 
 	// getQueryContext allows for querying state a historical height.
 	getQueryContext func(height int64, prove bool) (sdk.Context, error)
 
+	// abciQuery allows for querying the host chain's stores with proofs.
+	abciQuery func(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error)
+
 	// savedErr stores any error that is returned from state modifications on the underlying
 	// keepers.
 	savedErr error
@@ -234,6 +253,11 @@ func (p *plugin) DeleteAccounts(accounts []common.Address) {
 			continue
 		}
 
+		// never delete the marker code of a precompile
+		if p.GetCodeHash(account) == precompile.MarkerCodeHash {
+			continue
+		}
+
 		// clear storage
 		_ = p.ForEachStorage(account,
 			func(key, _ common.Hash) bool {
@@ -255,11 +279,18 @@ func (p *plugin) DeleteAccounts(accounts []common.Address) {
 
 // GetBalance implements `StatePlugin` interface.
 func (p *plugin) GetBalance(addr common.Address) *big.Int {
+	if p.bk != nil {
+		return p.getBankBalance(addr)
+	}
 	return new(big.Int).SetBytes(p.ctx.KVStore(p.storeKey).Get(BalanceKeyFor(addr)))
 }
 
 // SetBalance implements `StatePlugin` interface.
 func (p *plugin) SetBalance(addr common.Address, amount *big.Int) {
+	if p.bk != nil {
+		p.setBankBalance(addr, amount)
+		return
+	}
 	p.ctx.KVStore(p.storeKey).Set(BalanceKeyFor(addr), amount.Bytes())
 }
 
@@ -472,6 +503,11 @@ func getStateFromStore(
 }
 
 func (p *plugin) IterateBalances(fn func(common.Address, *big.Int) bool) {
+	if p.bk != nil {
+		p.iterateBankBalances(fn)
+		return
+	}
+
 	it := storetypes.KVStorePrefixIterator(
 		p.cms.GetKVStore(p.storeKey),
 		[]byte{types.BalanceKeyPrefix},
@@ -490,6 +526,26 @@ func (p *plugin) IterateBalances(fn func(common.Address, *big.Int) bool) {
 	}
 }
 
+// iterateCodeHashes iterates over the addresses of all accounts that have a code hash and calls the
+// given callback function.
+func (p *plugin) iterateCodeHashes(fn func(common.Address) bool) {
+	it := storetypes.KVStorePrefixIterator(
+		p.cms.GetKVStore(p.storeKey),
+		[]byte{types.CodeHashKeyPrefix},
+	)
+	defer func() {
+		if err := it.Close(); err != nil {
+			p.savedErr = err
+		}
+	}()
+
+	for ; it.Valid(); it.Next() {
+		if fn(AddressFromCodeHashKey(it.Key())) {
+			break
+		}
+	}
+}
+
 // =============================================================================
 // Historical State
 // =============================================================================
@@ -523,7 +579,8 @@ func (p *plugin) StateAtBlockNumber(number uint64) (core.StatePlugin, error) {
 	}
 
 	// Create a State Plugin with the requested chain height.
-	sp := NewPlugin(p.ak, p.storeKey, p.plf)
+	sp := p.newPlugin()
+	sp.SetABCIQueryFn(p.abciQuery)
 	sp.Reset(ctx)
 	return sp, nil
 }
@@ -534,12 +591,21 @@ func (p *plugin) StateAtBlockNumber(number uint64) (core.StatePlugin, error) {
 
 // Clone implements libtypes.Cloneable.
 func (p *plugin) Clone() ethstate.Plugin {
-	sp := NewPlugin(p.ak, p.storeKey, p.plf)
+	sp := p.newPlugin()
 	cacheCtx, _ := p.ctx.CacheContext()
 	sp.Reset(cacheCtx)
 	return sp
 }
 
+// newPlugin returns a new plugin with the same keepers and configuration as this plugin.
+func (p *plugin) newPlugin() Plugin {
+	sp := NewPlugin(p.ak, p.storeKey, p.plf)
+	if p.bk != nil {
+		sp.SetBankBalances(p.bk, p.denom, p.decimals)
+	}
+	return sp
+}
+
 // SetGasConfig implements Plugin.
 func (p *plugin) SetGasConfig(kvGasConfig, transientKVGasConfig storetypes.GasConfig) {
 	p.ctx = p.ctx.WithKVGasConfig(kvGasConfig).WithTransientKVGasConfig(transientKVGasConfig)
@@ -29,6 +29,7 @@ import (
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/precompile"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/eth/crypto"
 
@@ -317,6 +318,15 @@ var _ = Describe("State Plugin", func() {
 				Expect(sp.GetCode(alice)).To(BeNil())
 				Expect(sp.GetState(alice, common.BytesToHash([]byte{1}))).To(Equal(common.Hash{}))
 			})
+
+			It("should not remove the marker code of a precompile", func() {
+				sp.CreateAccount(bob)
+				sp.SetCode(bob, precompile.MarkerCode)
+				sp.DeleteAccounts([]common.Address{alice, bob})
+				Expect(ak.HasAccount(ctx, alice[:])).To(BeFalse())
+				Expect(sp.Exist(bob)).To(BeTrue())
+				Expect(sp.GetCode(bob)).To(Equal(precompile.MarkerCode))
+			})
 		})
 
 		Describe("TestAccount", func() {
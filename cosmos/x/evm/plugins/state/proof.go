@@ -0,0 +1,283 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// accountProofLen is the number of proofs in an account proof: the balance, code hash and auth
+// account proofs.
+const accountProofLen = 3
+
+// ErrBankBalanceProof is returned when an account proof is requested while the native balance is
+// backed by a bank denom.
+var ErrBankBalanceProof = errors.New("account proofs are not supported with bank balances")
+
+// ===========================================================================
+// Proofs
+// ===========================================================================
+
+// SetABCIQueryFn sets the ABCI query func for the plugin.
+func (p *plugin) SetABCIQueryFn(
+	fn func(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error),
+) {
+	p.abciQuery = fn
+}
+
+// StateRoot returns the app hash of the host chain. Since the host chain commits state after the
+// block is finalized, this is the commitment to the state as of the end of the previous block.
+//
+// StateRoot implements `ethstate.ProvablePlugin`.
+func (p *plugin) StateRoot() common.Hash {
+	return common.BytesToHash(p.ctx.BlockHeader().AppHash)
+}
+
+// GetProof returns the proofs of the balance and code hash of the given account in the evm store,
+// as well as the proof of its auth account, which holds its nonce, in the auth store, at the
+// height of the plugin's context. Proofs are not supported when the native balance is backed by a
+// bank denom, as the balance is then kept in the bank store and cannot be proven by the evm store.
+//
+// GetProof implements `ethstate.ProvablePlugin`.
+func (p *plugin) GetProof(addr common.Address) ([][]byte, error) {
+	if p.bk != nil {
+		return nil, ErrBankBalanceProof
+	}
+	balanceProof, err := p.proveKey(p.storeKey.Name(), BalanceKeyFor(addr))
+	if err != nil {
+		return nil, err
+	}
+	codeHashProof, err := p.proveKey(p.storeKey.Name(), CodeHashKeyFor(addr))
+	if err != nil {
+		return nil, err
+	}
+	accountProof, err := p.proveKey(authtypes.StoreKey, accountKeyFor(addr))
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{balanceProof, codeHashProof, accountProof}, nil
+}
+
+// GetStorageProof returns the proof of the given storage slot of the given account in the evm
+// store, at the height of the plugin's context.
+//
+// GetStorageProof implements `ethstate.ProvablePlugin`.
+func (p *plugin) GetStorageProof(addr common.Address, slot common.Hash) ([][]byte, error) {
+	slotProof, err := p.proveKey(p.storeKey.Name(), SlotKeyFor(addr, slot))
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{slotProof}, nil
+}
+
+// proveKey queries the host chain for the proof of the given key in the given store and returns
+// the encoded `ProofOps`. The proof is built against the app hash committed at the height of the
+// plugin's context.
+func (p *plugin) proveKey(storeName string, key []byte) ([]byte, error) {
+	if p.abciQuery == nil {
+		return nil, errors.New("no abci query function set in host chain")
+	}
+
+	res, err := p.abciQuery(p.ctx, &abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", storeName),
+		Data:   key,
+		Height: p.ctx.BlockHeight(),
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !res.IsOK() {
+		return nil, fmt.Errorf("failed to query proof: %s", res.Log)
+	}
+	if res.ProofOps == nil {
+		return nil, errors.New("host chain returned no proof")
+	}
+	return res.ProofOps.Marshal()
+}
+
+// ===========================================================================
+// Verification
+// ===========================================================================
+
+// VerifyAccountProof verifies an account proof returned by `eth_getProof` for the given balance,
+// nonce and code hash. `root` is the state root of the block following the block that the proof
+// was requested for, since the host chain commits the state of a block in the next block's header.
+// The codec must be able to decode the auth accounts of the host chain.
+func VerifyAccountProof(
+	cdc codec.BinaryCodec, root common.Hash, storeKey string, addr common.Address,
+	balance *big.Int, nonce uint64, codeHash common.Hash, proof [][]byte,
+) error {
+	if len(proof) != accountProofLen {
+		return fmt.Errorf("invalid account proof length: have %d, want %d", len(proof), accountProofLen)
+	}
+
+	// A zero balance may either be stored as an empty value or not be stored at all.
+	if err := verifyKey(
+		root, storeKey, BalanceKeyFor(addr), balance.Bytes(), balance.Sign() == 0, proof[0],
+	); err != nil {
+		return fmt.Errorf("invalid balance proof: %w", err)
+	}
+
+	// A non-existent account has no code hash and an account without code may either have the
+	// empty code hash stored or no code hash stored at all.
+	var value []byte
+	if (codeHash != common.Hash{}) {
+		value = codeHash.Bytes()
+	}
+	if err := verifyKey(
+		root, storeKey, CodeHashKeyFor(addr), value, codeHash == emptyCodeHash, proof[1],
+	); err != nil {
+		return fmt.Errorf("invalid code hash proof: %w", err)
+	}
+
+	if err := verifyNonce(cdc, root, addr, nonce, proof[2]); err != nil {
+		return fmt.Errorf("invalid nonce proof: %w", err)
+	}
+	return nil
+}
+
+// VerifyStorageProof verifies a storage proof returned by `eth_getProof` for the given value of
+// the storage slot. `root` is the state root of the block following the block that the proof was
+// requested for.
+func VerifyStorageProof(
+	root common.Hash, storeKey string, addr common.Address,
+	slot, value common.Hash, proof [][]byte,
+) error {
+	if len(proof) != 1 {
+		return fmt.Errorf("invalid storage proof length: have %d, want 1", len(proof))
+	}
+
+	// Empty slots are deleted from the store, so they must be proven absent.
+	var bz []byte
+	if (value != common.Hash{}) {
+		bz = value.Bytes()
+	}
+	return verifyKey(root, storeKey, SlotKeyFor(addr, slot), bz, false, proof[0])
+}
+
+// verifyKey verifies the encoded `ProofOps` of the given key in the given store against the root.
+// If value is nil the key must be absent, otherwise the key must exist with the given value or, if
+// `canBeAbsent` is true, be absent.
+func verifyKey(
+	root common.Hash, storeKey string, key, value []byte, canBeAbsent bool, bz []byte,
+) error {
+	var proof cmtcrypto.ProofOps
+	if err := proof.Unmarshal(bz); err != nil {
+		return err
+	}
+
+	keyPath := keyPathFor(storeKey, key)
+	prt := rootmulti.DefaultProofRuntime()
+
+	if value != nil {
+		err := prt.VerifyValue(&proof, root.Bytes(), keyPath, value)
+		if err == nil || !canBeAbsent {
+			return err
+		}
+	}
+	return prt.VerifyAbsence(&proof, root.Bytes(), keyPath)
+}
+
+// verifyNonce verifies the encoded `ProofOps` of the auth account of the given address against the
+// root, and that the sequence of the proven account is the given nonce. An account that does not
+// exist must be proven absent, with a nonce of zero.
+func verifyNonce(
+	cdc codec.BinaryCodec, root common.Hash, addr common.Address, nonce uint64, bz []byte,
+) error {
+	var proof cmtcrypto.ProofOps
+	if err := proof.Unmarshal(bz); err != nil {
+		return err
+	}
+
+	keyPath := keyPathFor(authtypes.StoreKey, accountKeyFor(addr))
+	prt := rootmulti.DefaultProofRuntime()
+
+	// The encoded account is not known by the verifier, so it is taken from the existence proof
+	// and proven against the root before being decoded.
+	value := existenceValue(&proof)
+	if value == nil {
+		if nonce != 0 {
+			return fmt.Errorf("account does not exist, but nonce is %d", nonce)
+		}
+		return prt.VerifyAbsence(&proof, root.Bytes(), keyPath)
+	}
+	if err := prt.VerifyValue(&proof, root.Bytes(), keyPath, value); err != nil {
+		return err
+	}
+
+	var acc sdk.AccountI
+	if err := cdc.UnmarshalInterface(value, &acc); err != nil {
+		return err
+	}
+	if acc.GetSequence() != nonce {
+		return fmt.Errorf("nonce mismatch: have %d, want %d", acc.GetSequence(), nonce)
+	}
+	return nil
+}
+
+// existenceValue returns the value proven by the existence proof of the store, which is the first
+// operation of the `ProofOps`, or nil if it is not an existence proof.
+func existenceValue(proof *cmtcrypto.ProofOps) []byte {
+	if len(proof.Ops) == 0 {
+		return nil
+	}
+	op, err := storetypes.CommitmentOpDecoder(proof.Ops[0])
+	if err != nil {
+		return nil
+	}
+	commitmentOp, ok := op.(storetypes.CommitmentOp)
+	if !ok {
+		return nil
+	}
+	return commitmentOp.Proof.GetExist().GetValue()
+}
+
+// keyPathFor returns the merkle key path of the given key in the given store.
+func keyPathFor(storeKey string, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
+
+// accountKeyFor returns the key of the auth account of the given address in the auth store.
+func accountKeyFor(addr common.Address) []byte {
+	prefix := authtypes.AddressStoreKeyPrefix.Bytes()
+	key := make([]byte, 0, len(prefix)+common.AddressLength)
+	return append(append(key, prefix...), addr.Bytes()...)
+}
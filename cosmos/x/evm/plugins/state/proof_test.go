@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Proofs", func() {
+	var (
+		sp       state.Plugin
+		cdc      codec.Codec
+		root     common.Hash
+		storeKey = testutil.EvmKey.Name()
+		code     = []byte{1, 2, 3}
+		slot     = common.BytesToHash([]byte{1})
+		value    = common.BytesToHash([]byte{2})
+	)
+
+	BeforeEach(func() {
+		ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+		for _, key := range []storetypes.StoreKey{
+			testutil.AccKey, testutil.BankKey, testutil.EvmKey, testutil.StakingKey,
+		} {
+			ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		}
+		Expect(ms.LoadLatestVersion()).To(Succeed())
+
+		// Commit the state of alice at height 1.
+		_, ak, _, _ := testutil.SetupMinimalKeepers()
+		ctx := testutil.NewContextWithMultiStore(ms).WithBlockHeight(1)
+		writer := state.NewPlugin(ak, testutil.EvmKey, &mockPLF{})
+		writer.Reset(ctx)
+		writer.CreateAccount(alice)
+		writer.AddBalance(alice, big.NewInt(50))
+		writer.SetNonce(alice, 3)
+		writer.SetCode(alice, code)
+		writer.SetState(alice, slot, value)
+		writer.Finalize()
+		root = common.BytesToHash(ms.Commit().Hash)
+
+		sp = state.NewPlugin(ak, testutil.EvmKey, &mockPLF{})
+		sp.SetABCIQueryFn(func(
+			_ context.Context, req *abci.RequestQuery,
+		) (*abci.ResponseQuery, error) {
+			res, err := ms.Query(&storetypes.RequestQuery{
+				Path:   strings.TrimPrefix(req.Path, "/store"),
+				Data:   req.Data,
+				Height: req.Height,
+				Prove:  req.Prove,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &abci.ResponseQuery{
+				Code: res.Code, Log: res.Log, Value: res.Value, ProofOps: res.ProofOps,
+			}, nil
+		})
+		sp.Reset(ctx)
+		cdc = testutil.GetEncodingConfig().Codec
+	})
+
+	Describe("account proofs", func() {
+		It("should verify the balance, nonce and code hash of an account", func() {
+			proof, err := sp.GetProof(alice)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(proof).To(HaveLen(3))
+			Expect(state.VerifyAccountProof(
+				cdc, root, storeKey, alice, big.NewInt(50), 3, crypto.Keccak256Hash(code), proof,
+			)).To(Succeed())
+		})
+
+		It("should verify a non-existent account", func() {
+			proof, err := sp.GetProof(bob)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.VerifyAccountProof(
+				cdc, root, storeKey, bob, new(big.Int), 0, common.Hash{}, proof,
+			)).To(Succeed())
+		})
+
+		It("should reject wrong account values", func() {
+			proof, err := sp.GetProof(alice)
+			Expect(err).ToNot(HaveOccurred())
+			codeHash := crypto.Keccak256Hash(code)
+			Expect(state.VerifyAccountProof(
+				cdc, root, storeKey, alice, big.NewInt(51), 3, codeHash, proof,
+			)).To(MatchError(ContainSubstring("invalid balance proof")))
+			Expect(state.VerifyAccountProof(
+				cdc, root, storeKey, alice, big.NewInt(50), 4, codeHash, proof,
+			)).To(MatchError(ContainSubstring("invalid nonce proof")))
+			Expect(state.VerifyAccountProof(
+				cdc, root, storeKey, alice, big.NewInt(50), 3, emptyCodeHash, proof,
+			)).To(MatchError(ContainSubstring("invalid code hash proof")))
+			Expect(state.VerifyAccountProof(
+				cdc, root, storeKey, bob, new(big.Int), 3, common.Hash{}, proof,
+			)).To(HaveOccurred())
+		})
+
+		It("should reject a tampered proof", func() {
+			proof, err := sp.GetProof(alice)
+			Expect(err).ToNot(HaveOccurred())
+			codeHash := crypto.Keccak256Hash(code)
+
+			// Swap the proof of the auth account with the proof of the balance.
+			swapped := [][]byte{proof[0], proof[1], proof[0]}
+			Expect(state.VerifyAccountProof(
+				cdc, root, storeKey, alice, big.NewInt(50), 3, codeHash, swapped,
+			)).To(MatchError(ContainSubstring("invalid nonce proof")))
+
+			// Flip a byte of the proof of the code hash.
+			tampered := append([]byte{}, proof[1]...)
+			tampered[len(tampered)/2] ^= 0xff
+			Expect(state.VerifyAccountProof(
+				cdc, root, storeKey, alice, big.NewInt(50), 3, codeHash,
+				[][]byte{proof[0], tampered, proof[2]},
+			)).To(HaveOccurred())
+
+			// Verify against the wrong root.
+			Expect(state.VerifyAccountProof(
+				cdc, common.Hash{1}, storeKey, alice, big.NewInt(50), 3, codeHash, proof,
+			)).To(HaveOccurred())
+
+			Expect(state.VerifyAccountProof(
+				cdc, root, storeKey, alice, big.NewInt(50), 3, codeHash, proof[:2],
+			)).To(MatchError(ContainSubstring("invalid account proof length")))
+		})
+	})
+
+	Describe("storage proofs", func() {
+		It("should verify the value of a storage slot", func() {
+			proof, err := sp.GetStorageProof(alice, slot)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.VerifyStorageProof(root, storeKey, alice, slot, value, proof)).To(Succeed())
+		})
+
+		It("should verify an empty storage slot", func() {
+			empty := common.BytesToHash([]byte{3})
+			proof, err := sp.GetStorageProof(alice, empty)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.VerifyStorageProof(
+				root, storeKey, alice, empty, common.Hash{}, proof,
+			)).To(Succeed())
+			Expect(state.VerifyStorageProof(root, storeKey, alice, empty, value, proof)).
+				ToNot(Succeed())
+		})
+
+		It("should reject a wrong value or a tampered proof", func() {
+			proof, err := sp.GetStorageProof(alice, slot)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.VerifyStorageProof(
+				root, storeKey, alice, slot, common.BytesToHash([]byte{3}), proof,
+			)).ToNot(Succeed())
+
+			tampered := append([]byte{}, proof[0]...)
+			tampered[len(tampered)/2] ^= 0xff
+			Expect(state.VerifyStorageProof(
+				root, storeKey, alice, slot, value, [][]byte{tampered},
+			)).ToNot(Succeed())
+		})
+	})
+})
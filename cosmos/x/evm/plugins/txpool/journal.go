@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// journalLoadBatch is the number of transactions that are re-injected at once when loading the
+// journal.
+const journalLoadBatch = 1024
+
+// errNoActiveJournal is returned if a transaction is attempted to be inserted into the journal,
+// but no such file is currently open.
+var errNoActiveJournal = errors.New("no active journal")
+
+// devNull is a WriteCloser that discards anything written into it, used to make sure that no
+// transactions are journaled while the journal itself is being loaded.
+type devNull struct{}
+
+func (*devNull) Write(p []byte) (int, error) { return len(p), nil }
+func (*devNull) Close() error                { return nil }
+
+// journal is a rotating log of RLP encoded transactions (similar to the `transactions.rlp` of
+// go-ethereum), used to persist the local transactions of the node so that they can survive
+// restarts.
+type journal struct {
+	// path is the filesystem path of the journal.
+	path string
+	// writer is the output stream of the journal, nil if the journal is not open.
+	writer io.WriteCloser
+}
+
+// newJournal creates a new transaction journal at the given path.
+func newJournal(path string) *journal {
+	return &journal{
+		path: path,
+	}
+}
+
+// load parses the transactions from the journal on disk and passes them in batches to the
+// given callback, which returns an error for every transaction it rejects. Load returns the
+// number of transactions that were read and the number that were rejected.
+func (j *journal) load(add func(coretypes.Transactions) []error) (int, int, error) {
+	// Open the journal for loading any past transactions
+	input, err := os.Open(j.path) //#nosec: G304 // path is configured by the node operator.
+	if errors.Is(err, fs.ErrNotExist) {
+		// Skip the parsing if the journal file doesn't exist at all
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	defer input.Close()
+
+	// Temporarily discard any journal additions (don't double add on load)
+	j.writer = new(devNull)
+	defer func() { j.writer = nil }()
+
+	// Inject all transactions from the journal into the pool
+	var (
+		stream  = rlp.NewStream(input, 0)
+		total   int
+		dropped int
+		batch   coretypes.Transactions
+	)
+	loadBatch := func(txs coretypes.Transactions) {
+		for _, err = range add(txs) {
+			if err != nil {
+				dropped++
+			}
+		}
+	}
+	for {
+		// Parse the next transaction and terminate on error
+		tx := new(coretypes.Transaction)
+		if err = stream.Decode(tx); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			if batch.Len() > 0 {
+				loadBatch(batch)
+			}
+			break
+		}
+
+		// New transaction parsed, queue up for later, import if threshold is reached
+		total++
+		if batch = append(batch, tx); batch.Len() >= journalLoadBatch {
+			loadBatch(batch)
+			batch = batch[:0]
+		}
+	}
+	return total, dropped, err
+}
+
+// insert adds the specified transaction to the local disk journal.
+func (j *journal) insert(tx *coretypes.Transaction) error {
+	if j.writer == nil {
+		return errNoActiveJournal
+	}
+	return rlp.Encode(j.writer, tx)
+}
+
+// rotate regenerates the transaction journal based on the current contents of the transaction
+// pool.
+func (j *journal) rotate(all map[common.Address]coretypes.Transactions) error {
+	// Close the current journal (if any is open)
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+		j.writer = nil
+	}
+
+	// Generate a new journal with the contents of the current pool
+	replacement, err := os.OpenFile( //#nosec: G302,G304 // path is configured by the node operator.
+		j.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644, //nolint:gomnd // file mode.
+	)
+	if err != nil {
+		return err
+	}
+	for _, txs := range all {
+		for _, tx := range txs {
+			if err = rlp.Encode(replacement, tx); err != nil {
+				replacement.Close()
+				return err
+			}
+		}
+	}
+	replacement.Close()
+
+	// Replace the live journal with the newly generated one
+	if err = os.Rename(j.path+".new", j.path); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile( //#nosec: G302,G304 // path is configured by the node operator.
+		j.path, os.O_WRONLY|os.O_APPEND, 0o644, //nolint:gomnd // file mode.
+	)
+	if err != nil {
+		return err
+	}
+	j.writer = sink
+	return nil
+}
+
+// close flushes the transaction journal contents to disk and closes the file.
+func (j *journal) close() error {
+	var err error
+	if j.writer != nil {
+		err = j.writer.Close()
+		j.writer = nil
+	}
+	return err
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Journal", func() {
+	var (
+		j    *journal
+		path string
+		txs  coretypes.Transactions
+	)
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "transactions.rlp")
+		j = newJournal(path)
+		txs = coretypes.Transactions{}
+		for i := uint64(0); i < 3; i++ {
+			txs = append(txs, coretypes.NewTransaction(
+				i, common.Address{0x1}, big.NewInt(1), 21000, big.NewInt(1), nil,
+			))
+		}
+	})
+
+	It("should load nothing if the journal does not exist", func() {
+		total, dropped, err := j.load(func(coretypes.Transactions) []error {
+			Fail("should not add any transactions")
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(total).To(BeZero())
+		Expect(dropped).To(BeZero())
+	})
+
+	It("should not insert without an active journal", func() {
+		Expect(j.insert(txs[0])).To(MatchError(errNoActiveJournal))
+	})
+
+	It("should rotate, insert and load the transactions", func() {
+		Expect(j.rotate(map[common.Address]coretypes.Transactions{{0x2}: txs[:2]})).To(Succeed())
+		Expect(j.insert(txs[2])).To(Succeed())
+		Expect(j.close()).To(Succeed())
+
+		var loaded coretypes.Transactions
+		total, dropped, err := newJournal(path).load(func(batch coretypes.Transactions) []error {
+			loaded = append(loaded, batch...)
+			errs := make([]error, len(batch))
+			errs[0] = errors.New("rejected")
+			return errs
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(total).To(Equal(3))
+		Expect(dropped).To(Equal(1))
+		Expect(loaded).To(HaveLen(3))
+		for i, tx := range loaded {
+			Expect(tx.Hash()).To(Equal(txs[i].Hash()))
+		}
+	})
+
+	It("should drop the transactions that are not in the pool on rotation", func() {
+		Expect(j.rotate(map[common.Address]coretypes.Transactions{{0x2}: txs})).To(Succeed())
+		Expect(j.rotate(map[common.Address]coretypes.Transactions{{0x2}: txs[2:]})).To(Succeed())
+		Expect(j.close()).To(Succeed())
+
+		total, _, err := newJournal(path).load(func(batch coretypes.Transactions) []error {
+			return make([]error, len(batch))
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(total).To(Equal(1))
+	})
+})
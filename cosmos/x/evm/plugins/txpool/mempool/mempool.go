@@ -23,10 +23,15 @@ package mempool
 import (
 	"math/big"
 	"sync"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 
+	"github.com/ethereum/go-ethereum/event"
+
 	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/txpool"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 )
 
@@ -52,6 +57,33 @@ type EthTxPool struct {
 	// by nonce.
 	nonceToHash map[common.Address]map[uint64]common.Hash
 
+	// cosmosTxCache caches the Cosmos transactions that wrap the Ethereum transactions in the
+	// mempool, so that they can be evicted by hash.
+	cosmosTxCache map[common.Hash]sdk.Tx
+
+	// txTimes records when the Ethereum transactions were added to the mempool, it is used to
+	// evict the stale queued transactions.
+	txTimes map[common.Hash]time.Time
+
+	// announced is the set of pending Ethereum transactions that were already sent to the new
+	// txs subscribers.
+	announced map[common.Hash]struct{}
+
+	// pendingBySender and queuedBySender count the pending and queued Ethereum transactions of
+	// each sender, and numPending and numQueued count them in total. They are kept up to date by
+	// `promote`, so that the limits are enforced without classifying every transaction.
+	pendingBySender, queuedBySender map[common.Address]uint64
+	numPending, numQueued           uint64
+
+	// txFeed and scope are used to send the batches of transactions that enter the pending set to
+	// the new txs subscribers.
+	txFeed event.Feed
+	scope  event.SubscriptionScope
+
+	// cfg holds the limits (AccountSlots, AccountQueue, GlobalSlots, GlobalQueue and Lifetime)
+	// that are enforced on the Ethereum transactions in the mempool.
+	cfg txpool.Config
+
 	// We have a mutex to protect the ethTxCache and nonces maps since they are accessed
 	// concurrently by multiple goroutines.
 	mu sync.RWMutex
@@ -73,23 +105,79 @@ func NewPolarisEthereumTxPool() *EthTxPool {
 			},
 			MinValue: big.NewInt(-1),
 		},
-		MaxTx: 10000, //nolint:gomnd // todo: parametize this.
+		// The Ethereum transactions are bounded by the limits enforced in `Insert`.
+		MaxTx: 0,
 	}
 
 	return &EthTxPool{
 		PriorityNonceMempool: mempool.NewPriorityMempool(config),
 		nonceToHash:          make(map[common.Address]map[uint64]common.Hash),
 		ethTxCache:           make(map[common.Hash]*coretypes.Transaction),
+		cosmosTxCache:        make(map[common.Hash]sdk.Tx),
+		txTimes:              make(map[common.Hash]time.Time),
+		announced:            make(map[common.Hash]struct{}),
+		pendingBySender:      make(map[common.Address]uint64),
+		queuedBySender:       make(map[common.Address]uint64),
 		priorityPolicy:       &tpp,
+		cfg:                  defaultConfig(),
 	}
 }
 
+// defaultConfig returns the default limits of the mempool, which do not enforce a minimum tip
+// unless one is configured by the operator.
+func defaultConfig() txpool.Config {
+	cfg := txpool.DefaultConfig
+	cfg.PriceLimit = 0
+	return cfg
+}
+
+// SetConfig sets the limits of the mempool, any unset (zero) limit falls back to its default,
+// except for the `PriceLimit` (the minimum tip) which is not enforced if unset.
+func (etp *EthTxPool) SetConfig(cfg txpool.Config) {
+	etp.mu.Lock()
+	defer etp.mu.Unlock()
+
+	if cfg.AccountSlots == 0 {
+		cfg.AccountSlots = txpool.DefaultConfig.AccountSlots
+	}
+	if cfg.GlobalSlots == 0 {
+		cfg.GlobalSlots = txpool.DefaultConfig.GlobalSlots
+	}
+	if cfg.AccountQueue == 0 {
+		cfg.AccountQueue = txpool.DefaultConfig.AccountQueue
+	}
+	if cfg.GlobalQueue == 0 {
+		cfg.GlobalQueue = txpool.DefaultConfig.GlobalQueue
+	}
+	if cfg.Lifetime == 0 {
+		cfg.Lifetime = txpool.DefaultConfig.Lifetime
+	}
+	etp.cfg = cfg
+}
+
 // SetNonceRetriever sets the nonce retriever db for the mempool.
 func (etp *EthTxPool) SetNonceRetriever(nr NonceRetriever) {
 	etp.nr = nr
 }
 
-// SetBaseFee updates the base fee in the priority policy.
+// SetBaseFee updates the base fee in the priority policy. The txs whose fee cap is below the new
+// base fee can no longer be included, so they (and the following txs of their senders) are no
+// longer considered pending. Conversely, the txs that can be included again are promoted. As it
+// is called once per block, the queued txs that outlived their lifetime are evicted as well.
 func (etp *EthTxPool) SetBaseFee(baseFee *big.Int) {
+	etp.mu.Lock()
 	etp.priorityPolicy.baseFee = baseFee
+	var promoted coretypes.Transactions
+	for sender := range etp.nonceToHash {
+		promoted = append(promoted, etp.promote(sender)...)
+	}
+	etp.evictExpired()
+	etp.mu.Unlock()
+
+	etp.announce(promoted)
+}
+
+// isExecutable returns whether the given tx can pay the current base fee.
+func (etp *EthTxPool) isExecutable(ethTx *coretypes.Transaction) bool {
+	return etp.priorityPolicy.baseFee == nil || ethTx.GasFeeCapIntCmp(etp.priorityPolicy.baseFee) >= 0
 }
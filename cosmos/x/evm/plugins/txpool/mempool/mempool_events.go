@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mempool
+
+import (
+	"github.com/ethereum/go-ethereum/event"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// SubscribeNewTxsEvent returns a new event subscription for the txs that enter the pending set of
+// the mempool, whether they were sent through this node, gossiped by peers or promoted from the
+// queued txs.
+func (etp *EthTxPool) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
+	return etp.scope.Track(etp.txFeed.Subscribe(ch))
+}
+
+// promote returns the txs of the given sender that entered the pending set (the executable txs
+// whose nonces are contiguous with the nonce of the sender) since it was last promoted. The txs
+// that left the pending set are forgotten, so that they are announced again once promoted. The
+// pending and queued txs of the sender are recounted along the way.
+//
+// NOTE: this function must be called with the mempool lock held.
+func (etp *EthTxPool) promote(sender common.Address) coretypes.Transactions {
+	nonces := etp.nonceToHash[sender]
+	pending := make(map[common.Hash]struct{})
+	var promoted coretypes.Transactions
+	for next := etp.nr.GetNonce(sender); ; next++ {
+		hash, ok := nonces[next]
+		if !ok || !etp.isExecutable(etp.ethTxCache[hash]) {
+			break
+		}
+		pending[hash] = struct{}{}
+		if _, ok = etp.announced[hash]; !ok {
+			etp.announced[hash] = struct{}{}
+			promoted = append(promoted, etp.ethTxCache[hash])
+		}
+	}
+	for _, hash := range nonces {
+		if _, ok := pending[hash]; !ok {
+			delete(etp.announced, hash)
+		}
+	}
+	etp.recount(sender, uint64(len(pending)), uint64(len(nonces)-len(pending)))
+	return promoted
+}
+
+// announce sends a batched new txs event for the given promoted txs, if any.
+//
+// NOTE: this function must be called without the mempool lock held, as sending blocks until all
+// the subscribers received the event.
+func (etp *EthTxPool) announce(promoted coretypes.Transactions) {
+	if len(promoted) > 0 {
+		etp.txFeed.Send(core.NewTxsEvent{Txs: promoted})
+	}
+}
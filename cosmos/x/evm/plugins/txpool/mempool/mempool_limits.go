@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mempool
+
+import (
+	"time"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/txpool"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// enforceLimits makes sure that adding the given (non replacement) tx to the mempool respects
+// the limits of the mempool, and returns the txs that must be evicted once the tx is inserted. If
+// the pending (executable) txs are at the `GlobalSlots` cap, the cheapest pending tx of an account
+// above its `AccountSlots` is evicted to make room, and if the queued txs are at the `GlobalQueue`
+// cap, the stalest queued tx is evicted to make room. It relies on the counts of pending and
+// queued txs kept by `recount`, so that the mempool is not scanned on every insert.
+//
+// NOTE: this function must be called with the mempool lock held.
+func (etp *EthTxPool) enforceLimits(ethTx *coretypes.Transaction) (coretypes.Transactions, error) {
+	sender, nonce := coretypes.GetSender(ethTx), ethTx.Nonce()
+
+	// Replacements do not change the number of txs in the mempool.
+	if _, ok := etp.nonceToHash[sender][nonce]; ok {
+		return nil, nil
+	}
+
+	if nonce == etp.pendingNonce(sender) {
+		// The tx is executable, so it will be added to the pending txs.
+		if etp.numPending < etp.cfg.GlobalSlots {
+			return nil, nil
+		}
+		if etp.pendingBySender[sender] >= etp.cfg.AccountSlots {
+			return nil, ErrAccountLimitExceeded
+		}
+		cheapest, err := etp.cheapestPending(ethTx)
+		if err != nil {
+			return nil, err
+		}
+		return coretypes.Transactions{cheapest}, nil
+	}
+
+	// The tx is not executable, so it will be added to the queued txs.
+	if etp.queuedBySender[sender] >= etp.cfg.AccountQueue {
+		return nil, ErrAccountLimitExceeded
+	}
+	if etp.numQueued >= etp.cfg.GlobalQueue {
+		if stalest := etp.stalestQueued(); stalest != nil {
+			return coretypes.Transactions{stalest}, nil
+		}
+	}
+	return nil, nil
+}
+
+// cheapestPending returns the pending tx with the lowest effective tip out of the last pending
+// txs of the accounts that are above their `AccountSlots`, so that the remaining pending txs of
+// the account stay executable once it is evicted. It returns `txpool.ErrUnderpriced` if the
+// given tx does not pay a higher tip than the returned one, and `txpool.ErrTxPoolOverflow` if no
+// account is above its `AccountSlots`.
+func (etp *EthTxPool) cheapestPending(
+	ethTx *coretypes.Transaction,
+) (*coretypes.Transaction, error) {
+	var cheapest *coretypes.Transaction
+	for sender, pending := range etp.pendingBySender {
+		if pending <= etp.cfg.AccountSlots {
+			continue
+		}
+		last := etp.ethTxCache[etp.nonceToHash[sender][etp.pendingNonce(sender)-1]]
+		if cheapest == nil || last.EffectiveGasTipCmp(cheapest, etp.priorityPolicy.baseFee) < 0 {
+			cheapest = last
+		}
+	}
+
+	switch {
+	case cheapest == nil:
+		return nil, txpool.ErrTxPoolOverflow
+	case ethTx.EffectiveGasTipCmp(cheapest, etp.priorityPolicy.baseFee) <= 0:
+		return nil, txpool.ErrUnderpriced
+	default:
+		return cheapest, nil
+	}
+}
+
+// stalestQueued returns the queued tx that has been in the mempool for the longest time, breaking
+// ties by the lowest effective tip, or nil if there are no queued txs.
+func (etp *EthTxPool) stalestQueued() *coretypes.Transaction {
+	var stalest *coretypes.Transaction
+	for _, tx := range etp.queuedTxs() {
+		if stalest == nil {
+			stalest = tx
+			continue
+		}
+		txTime, stalestTime := etp.txTimes[tx.Hash()], etp.txTimes[stalest.Hash()]
+		if txTime.Before(stalestTime) || (txTime.Equal(stalestTime) &&
+			tx.EffectiveGasTipCmp(stalest, etp.priorityPolicy.baseFee) < 0) {
+			stalest = tx
+		}
+	}
+	return stalest
+}
+
+// evictExpired evicts the queued txs that have been in the mempool for longer than their
+// lifetime, it is called once per block.
+//
+// NOTE: this function must be called with the mempool lock held.
+func (etp *EthTxPool) evictExpired() {
+	for _, tx := range etp.queuedTxs() {
+		if time.Since(etp.txTimes[tx.Hash()]) > etp.cfg.Lifetime {
+			etp.evict(tx)
+		}
+	}
+}
+
+// queuedTxs returns the queued txs of the senders that have any.
+func (etp *EthTxPool) queuedTxs() coretypes.Transactions {
+	var queued coretypes.Transactions
+	for sender := range etp.queuedBySender {
+		next := etp.pendingNonce(sender)
+		for nonce, hash := range etp.nonceToHash[sender] {
+			if nonce >= next {
+				queued = append(queued, etp.ethTxCache[hash])
+			}
+		}
+	}
+	return queued
+}
+
+// evict removes the given tx from the mempool. Since only the last pending tx or queued txs of a
+// sender are evicted, no tx of the sender gets promoted.
+func (etp *EthTxPool) evict(ethTx *coretypes.Transaction) {
+	if tx, ok := etp.cosmosTxCache[ethTx.Hash()]; ok {
+		// The tx is known to be in the mempool, so the removal cannot fail.
+		_ = etp.PriorityNonceMempool.Remove(tx)
+	}
+	etp.uncache(ethTx)
+	_ = etp.promote(coretypes.GetSender(ethTx))
+}
+
+// pendingNonce returns the nonce following the pending txs of the given sender.
+func (etp *EthTxPool) pendingNonce(sender common.Address) uint64 {
+	return etp.nr.GetNonce(sender) + etp.pendingBySender[sender]
+}
+
+// recount updates the number of pending and queued txs of the given sender, as well as the total
+// number of pending and queued txs in the mempool.
+func (etp *EthTxPool) recount(sender common.Address, pending, queued uint64) {
+	etp.numPending = etp.numPending - etp.pendingBySender[sender] + pending
+	etp.numQueued = etp.numQueued - etp.queuedBySender[sender] + queued
+
+	if pending == 0 {
+		delete(etp.pendingBySender, sender)
+	} else {
+		etp.pendingBySender[sender] = pending
+	}
+	if queued == 0 {
+		delete(etp.queuedBySender, sender)
+	} else {
+		etp.queuedBySender[sender] = queued
+	}
+}
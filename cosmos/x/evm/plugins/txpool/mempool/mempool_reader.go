@@ -22,8 +22,10 @@ package mempool
 
 import (
 	"context"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 
 	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
@@ -32,6 +34,54 @@ import (
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 )
 
+// Select returns an iterator over the txs in the mempool, like the underlying mempool, but skips
+// the Ethereum txs that cannot pay the current base fee, along with the following txs of their
+// senders, so that the demoted txs are not selected for inclusion in a block.
+func (etp *EthTxPool) Select(ctx context.Context, txs [][]byte) mempool.Iterator {
+	etp.mu.RLock()
+	baseFee := etp.priorityPolicy.baseFee
+	etp.mu.RUnlock()
+
+	iter := &executableIterator{baseFee: baseFee, skipped: make(map[common.Address]struct{})}
+	return iter.seek(etp.PriorityNonceMempool.Select(ctx, txs))
+}
+
+// executableIterator wraps an iterator of the underlying mempool to skip the Ethereum txs that
+// cannot pay the base fee and the following txs of their senders.
+type executableIterator struct {
+	mempool.Iterator
+
+	baseFee *big.Int
+	skipped map[common.Address]struct{}
+}
+
+// Next implements `mempool.Iterator`.
+func (it *executableIterator) Next() mempool.Iterator {
+	return it.seek(it.Iterator.Next())
+}
+
+// seek moves the given iterator to the first executable tx, or returns nil if there is none.
+func (it *executableIterator) seek(iter mempool.Iterator) mempool.Iterator {
+	for ; iter != nil; iter = iter.Next() {
+		ethTx := evmtypes.GetAsEthTx(iter.Tx())
+		if ethTx == nil {
+			break
+		}
+		sender := coretypes.GetSender(ethTx)
+		if _, ok := it.skipped[sender]; !ok {
+			if it.baseFee == nil || ethTx.GasFeeCapIntCmp(it.baseFee) >= 0 {
+				break
+			}
+			it.skipped[sender] = struct{}{}
+		}
+	}
+	if iter == nil {
+		return nil
+	}
+	it.Iterator = iter
+	return it
+}
+
 // Get is called when a transaction is retrieved from the mempool.
 func (etp *EthTxPool) Get(hash common.Hash) *coretypes.Transaction {
 	return etp.ethTxCache[hash]
@@ -56,14 +106,14 @@ func (etp *EthTxPool) Pending(bool) map[common.Address]coretypes.Transactions {
 				txNonce := ethTx.Nonce()
 				// If on the first lookup the nonce delta is more than 0, then there is a gap
 				// and thus no pending transactions, but there are queued transactions. We
-				// continue.
-				if sdbNonce := etp.nr.GetNonce(addr); txNonce-sdbNonce >= 1 {
+				// continue. If the tx cannot pay the base fee, it is not pending either.
+				if sdbNonce := etp.nr.GetNonce(addr); txNonce-sdbNonce >= 1 || !etp.isExecutable(ethTx) {
 					continue
 				}
 				// this is a pending tx, add it to the pending map.
 				pendingNonces[addr] = txNonce
 				pending[addr] = append(pending[addr], ethTx)
-			case ethTx.Nonce() == pendingNonce+1:
+			case ethTx.Nonce() == pendingNonce+1 && etp.isExecutable(ethTx):
 				// If its not the first tx, but the nonce is the same as the pending nonce, add
 				// it to the list.
 				pending[addr] = append(pending[addr], ethTx)
@@ -97,13 +147,13 @@ func (etp *EthTxPool) queued() map[common.Address]coretypes.Transactions {
 				pendingNonce = ethTx.Nonce()
 				// If on the first lookup the nonce delta is more than 0, then there is a gap
 				// and thus no pending transactions, but there are queued transactions.
-				if pendingNonce-etp.nr.GetNonce(addr) >= 1 {
+				if pendingNonce-etp.nr.GetNonce(addr) >= 1 || !etp.isExecutable(ethTx) {
 					queued[addr] = append(queued[addr], ethTx)
 				} else {
 					// this is a pending tx, add it to the pending map.
 					pendingNonces[addr] = pendingNonce
 				}
-			case ethTx.Nonce() == pendingNonce+1:
+			case ethTx.Nonce() == pendingNonce+1 && etp.isExecutable(ethTx):
 				// If we are still contiguous and the nonce is the same as the pending nonce,
 				// increment the pending nonce.
 				pendingNonce++
@@ -27,6 +27,7 @@ import (
 	"math/big"
 	"sync"
 	"testing"
+	"time"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 
@@ -41,9 +42,11 @@ import (
 	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/txpool"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/eth/crypto"
 	"pkg.berachain.dev/polaris/eth/params"
+	"pkg.berachain.dev/polaris/eth/polar"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -62,6 +65,7 @@ var (
 	addr1   = crypto.PubkeyToAddress(key1.PublicKey)
 	key2, _ = crypto.GenerateEthKey()
 	addr2   = crypto.PubkeyToAddress(key2.PublicKey)
+	key3, _ = crypto.GenerateEthKey()
 )
 
 var _ = Describe("EthTxPool", func() {
@@ -366,6 +370,237 @@ var _ = Describe("EthTxPool", func() {
 		})
 
 	})
+	Describe("Limits", func() {
+		price := func(p int64) *big.Int { return big.NewInt(p) }
+
+		It("should enforce the account queue limit", func() {
+			etp.SetConfig(txpool.Config{AccountQueue: 2})
+			_, tx3 := buildTx(key1, &coretypes.LegacyTx{Nonce: 3, GasPrice: price(1)})
+			_, tx4 := buildTx(key1, &coretypes.LegacyTx{Nonce: 4, GasPrice: price(1)})
+			_, tx5 := buildTx(key1, &coretypes.LegacyTx{Nonce: 5, GasPrice: price(1)})
+			Expect(etp.Insert(ctx, tx3)).To(Succeed())
+			Expect(etp.Insert(ctx, tx4)).To(Succeed())
+			Expect(etp.Insert(ctx, tx5)).To(MatchError(ErrAccountLimitExceeded))
+
+			// executable txs are not limited by the account queue limit
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(1)})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+		})
+
+		It("should keep count of the pending and queued txs", func() {
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(1)})
+			_, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 2, GasPrice: price(1)})
+			_, tx4 := buildTx(key1, &coretypes.LegacyTx{Nonce: 4, GasPrice: price(1)})
+			_, tx3 := buildTx(key2, &coretypes.LegacyTx{Nonce: 3, GasPrice: price(1)})
+			for _, tx := range []sdk.Tx{tx2, tx4, tx3} {
+				Expect(etp.Insert(ctx, tx)).To(Succeed())
+			}
+			Expect(etp.pendingBySender).To(BeEmpty())
+			Expect(etp.queuedBySender).To(HaveKeyWithValue(addr1, uint64(2)))
+			Expect(etp.numPending).To(BeZero())
+			Expect(etp.numQueued).To(Equal(uint64(3)))
+
+			// the queued txs of the sender are promoted once the gap is filled.
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(etp.pendingBySender).To(HaveKeyWithValue(addr1, uint64(2)))
+			Expect(etp.queuedBySender).To(HaveKeyWithValue(addr1, uint64(1)))
+			Expect(etp.numPending).To(Equal(uint64(2)))
+			Expect(etp.numQueued).To(Equal(uint64(2)))
+
+			// and demoted once it is opened again.
+			Expect(etp.Remove(tx2)).To(Succeed())
+			Expect(etp.pendingBySender).To(HaveKeyWithValue(addr1, uint64(1)))
+			Expect(etp.queuedBySender).To(HaveKeyWithValue(addr1, uint64(1)))
+			Expect(etp.numPending).To(Equal(uint64(1)))
+			Expect(etp.numQueued).To(Equal(uint64(2)))
+			pending, queued := etp.Stats()
+			Expect(pending).To(Equal(int(etp.numPending)))
+			Expect(queued).To(Equal(int(etp.numQueued)))
+		})
+
+		It("should evict the stalest queued tx when the global queue is full", func() {
+			etp.SetConfig(txpool.Config{GlobalQueue: 2})
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 3, GasPrice: price(1)})
+			ethTx2, tx2 := buildTx(key2, &coretypes.LegacyTx{Nonce: 4, GasPrice: price(1)})
+			ethTx3, tx3 := buildTx(key1, &coretypes.LegacyTx{Nonce: 5, GasPrice: price(1)})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(etp.Insert(ctx, tx2)).To(Succeed())
+			etp.txTimes[ethTx1.Hash()] = time.Now().Add(-time.Minute)
+
+			Expect(etp.Insert(ctx, tx3)).To(Succeed())
+			Expect(etp.Get(ethTx1.Hash())).To(BeNil())
+			Expect(etp.Get(ethTx2.Hash())).ToNot(BeNil())
+			Expect(isQueuedTx(etp, ethTx3)).To(BeTrue())
+			_, queued := etp.Stats()
+			Expect(queued).To(Equal(2))
+		})
+
+		It("should not evict any tx if the tx fails to be inserted", func() {
+			etp.SetConfig(txpool.Config{GlobalQueue: 1})
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 3, GasPrice: price(1)})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+
+			// the underlying mempool rejects txs without signatures.
+			_, tx2 := buildTx(key2, &coretypes.LegacyTx{Nonce: 4, GasPrice: price(1)})
+			tx2.(*mockSdkTx).signatures = nil
+			Expect(etp.Insert(ctx, tx2)).ToNot(Succeed())
+			Expect(etp.Get(ethTx1.Hash())).ToNot(BeNil())
+			Expect(isQueuedTx(etp, ethTx1)).To(BeTrue())
+		})
+
+		It("should evict the queued txs that outlived their lifetime", func() {
+			etp.SetConfig(txpool.Config{Lifetime: time.Minute})
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 3, GasPrice: price(1)})
+			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(1)})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(etp.Insert(ctx, tx2)).To(Succeed())
+			etp.txTimes[ethTx1.Hash()] = time.Now().Add(-2 * time.Minute)
+			etp.txTimes[ethTx2.Hash()] = time.Now().Add(-2 * time.Minute)
+
+			// the expired txs are evicted once per block, when the base fee is set.
+			Expect(etp.Get(ethTx1.Hash())).ToNot(BeNil())
+			etp.SetBaseFee(big.NewInt(0))
+			Expect(etp.Get(ethTx1.Hash())).To(BeNil())
+			Expect(isPendingTx(etp, ethTx2)).To(BeTrue())
+			Expect(etp.Stats()).To(Equal(1))
+		})
+
+		It("should evict the cheapest pending tx when the global slots are full", func() {
+			etp.SetConfig(txpool.Config{GlobalSlots: 2, AccountSlots: 1})
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(2)})
+			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 2, GasPrice: price(2)})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(etp.Insert(ctx, tx2)).To(Succeed())
+
+			// the new tx must pay more than the tx it would evict
+			_, underpriced := buildTx(key2, &coretypes.LegacyTx{Nonce: 2, GasPrice: price(2)})
+			Expect(etp.Insert(ctx, underpriced)).To(MatchError(txpool.ErrUnderpriced))
+
+			ethTx3, tx3 := buildTx(key2, &coretypes.LegacyTx{Nonce: 2, GasPrice: price(3)})
+			Expect(etp.Insert(ctx, tx3)).To(Succeed())
+			Expect(etp.Get(ethTx2.Hash())).To(BeNil())
+			Expect(isPendingTx(etp, ethTx3)).To(BeTrue())
+
+			// no account is above its account slots anymore
+			_, tx4 := buildTx(key2, &coretypes.LegacyTx{Nonce: 3, GasPrice: price(4)})
+			Expect(etp.Insert(ctx, tx4)).To(MatchError(ErrAccountLimitExceeded))
+			_, tx5 := buildTx(key3, &coretypes.LegacyTx{Nonce: 0, GasPrice: price(4)})
+			Expect(etp.Insert(ctx, tx5)).To(MatchError(txpool.ErrTxPoolOverflow))
+		})
+	})
+
+	Describe("Pricing", func() {
+		price := func(p int64) *big.Int { return big.NewInt(p) }
+
+		It("should reject txs below the price limit", func() {
+			etp.SetConfig(txpool.Config{PriceLimit: 5})
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(4)})
+			Expect(etp.Insert(ctx, tx1)).To(MatchError(txpool.ErrUnderpriced))
+
+			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(5)})
+			Expect(etp.Insert(ctx, tx2)).To(Succeed())
+			Expect(isPendingTx(etp, ethTx2)).To(BeTrue())
+		})
+
+		It("should accept txs without a tip with the default Polaris config", func() {
+			etp.SetConfig(polar.DefaultConfig().TxPool)
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(0)})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(isPendingTx(etp, ethTx1)).To(BeTrue())
+		})
+
+		It("should reject txs that cannot pay the base fee", func() {
+			etp.SetBaseFee(price(10))
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(5)})
+			Expect(etp.Insert(ctx, tx1)).To(MatchError(txpool.ErrUnderpriced))
+
+			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(10)})
+			Expect(etp.Insert(ctx, tx2)).To(Succeed())
+			Expect(isPendingTx(etp, ethTx2)).To(BeTrue())
+		})
+
+		It("should demote the non-executable txs when the base fee rises", func() {
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(5)})
+			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 2, GasPrice: price(20)})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(etp.Insert(ctx, tx2)).To(Succeed())
+			Expect(etp.Pending(false)[addr1]).To(HaveLen(2))
+
+			etp.SetBaseFee(price(10))
+			Expect(etp.Pending(false)[addr1]).To(BeEmpty())
+			Expect(isQueuedTx(etp, ethTx1)).To(BeTrue())
+			Expect(isQueuedTx(etp, ethTx2)).To(BeTrue())
+			pending, queued := etp.Stats()
+			Expect(pending).To(Equal(0))
+			Expect(queued).To(Equal(2))
+		})
+
+		It("should not select the non-executable txs", func() {
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: price(5)})
+			_, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 2, GasPrice: price(20)})
+			ethTx3, tx3 := buildTx(key2, &coretypes.LegacyTx{Nonce: 2, GasPrice: price(20)})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(etp.Insert(ctx, tx2)).To(Succeed())
+			Expect(etp.Insert(ctx, tx3)).To(Succeed())
+
+			etp.SetBaseFee(price(10))
+
+			// the tx of key1 that can pay the base fee follows a demoted tx, so it is skipped too.
+			var selected []*coretypes.Transaction
+			for iter := etp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+				selected = append(selected, evmtypes.GetAsEthTx(iter.Tx()))
+			}
+			Expect(selected).To(HaveLen(1))
+			Expect(selected[0].Hash()).To(Equal(ethTx3.Hash()))
+
+			etp.SetBaseFee(price(1))
+			iter := etp.Select(ctx, nil)
+			for i := 0; i < 3; i++ {
+				Expect(iter).ToNot(BeNil())
+				iter = iter.Next()
+			}
+			Expect(iter).To(BeNil())
+		})
+	})
+
+	Describe("Events", func() {
+		var ch chan core.NewTxsEvent
+
+		BeforeEach(func() {
+			ch = make(chan core.NewTxsEvent, 8)
+			sub := etp.SubscribeNewTxsEvent(ch)
+			DeferCleanup(sub.Unsubscribe)
+		})
+
+		It("should only announce the txs that enter the pending set", func() {
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 2})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Consistently(ch).ShouldNot(Receive())
+
+			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1})
+			Expect(etp.Insert(ctx, tx2)).To(Succeed())
+			var ev core.NewTxsEvent
+			Eventually(ch).Should(Receive(&ev))
+			Expect(ev.Txs).To(HaveLen(2))
+			Expect(ev.Txs[0].Hash()).To(Equal(ethTx2.Hash()))
+			Consistently(ch).ShouldNot(Receive())
+		})
+
+		It("should announce the txs promoted when the base fee drops", func() {
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(5)})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Eventually(ch).Should(Receive())
+
+			etp.SetBaseFee(big.NewInt(10))
+			Consistently(ch).ShouldNot(Receive())
+
+			var ev core.NewTxsEvent
+			etp.SetBaseFee(big.NewInt(1))
+			Eventually(ch).Should(Receive(&ev))
+			Expect(ev.Txs).To(HaveLen(1))
+		})
+	})
+
 	Describe("Race Cases", func() {
 		It("should handle concurrent additions", func() {
 
@@ -22,38 +22,83 @@ package mempool
 
 import (
 	"context"
+	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/txpool"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	errorslib "pkg.berachain.dev/polaris/lib/errors"
 )
 
-// Insert is called when a transaction is added to the mempool.
+// Insert is called when a transaction is added to the mempool. The txs that enter the pending set
+// as a result (the inserted tx and the queued txs it unblocks) are sent to the new txs
+// subscribers.
 func (etp *EthTxPool) Insert(ctx context.Context, tx sdk.Tx) error {
+	promoted, err := etp.insert(ctx, tx)
+	etp.announce(promoted)
+	return err
+}
+
+// insert adds the given transaction to the mempool and returns the promoted txs.
+func (etp *EthTxPool) insert(ctx context.Context, tx sdk.Tx) (coretypes.Transactions, error) {
 	etp.mu.Lock()
 	defer etp.mu.Unlock()
 
+	var evicted coretypes.Transactions
+	ethTx := evmtypes.GetAsEthTx(tx)
+	if ethTx != nil {
+		// Reject txs with a nonce lower than the nonce reported by the statedb.
+		if sdbNonce := etp.nr.GetNonce(coretypes.GetSender(ethTx)); sdbNonce > ethTx.Nonce() {
+			return nil, core.ErrNonceTooLow
+		}
+
+		// Reject txs that do not pay the minimum tip or cannot pay the current base fee.
+		if ethTx.GasTipCapIntCmp(new(big.Int).SetUint64(etp.cfg.PriceLimit)) < 0 {
+			return nil, errorslib.Wrapf(
+				txpool.ErrUnderpriced, "tip %v is below the minimum %d", ethTx.GasTipCap(), etp.cfg.PriceLimit,
+			)
+		}
+		if !etp.isExecutable(ethTx) {
+			return nil, errorslib.Wrapf(
+				txpool.ErrUnderpriced, "fee cap %v is below the base fee %v",
+				ethTx.GasFeeCap(), etp.priorityPolicy.baseFee,
+			)
+		}
+
+		// Enforce the account and global limits, finding the txs to evict to make room if needed.
+		var err error
+		if evicted, err = etp.enforceLimits(ethTx); err != nil {
+			return nil, err
+		}
+	}
+
 	// Call the base mempool's Insert method
 	if err := etp.PriorityNonceMempool.Insert(ctx, tx); err != nil {
-		return err
+		return nil, err
+	}
+
+	// Only evict the txs once the tx is inserted, so that a failed insert does not evict any.
+	for _, evictedTx := range evicted {
+		etp.evict(evictedTx)
 	}
 
 	// We want to cache the transaction for lookup.
-	if ethTx := evmtypes.GetAsEthTx(tx); ethTx != nil {
+	if ethTx != nil {
 		sender := coretypes.GetSender(ethTx)
 		nonce := ethTx.Nonce()
 
-		// Reject txs with a nonce lower than the nonce reported by the statedb.
-		if sdbNonce := etp.nr.GetNonce(sender); sdbNonce > nonce {
-			return errorslib.Wrap(etp.PriorityNonceMempool.Remove(tx), "nonce too low")
-		}
-
 		// Delete old hash if the sender has a tx with the same nonce.
 		if senderNonceHash := etp.nonceToHash[sender]; senderNonceHash != nil {
-			delete(etp.ethTxCache, senderNonceHash[nonce])
+			oldHash := senderNonceHash[nonce]
+			delete(etp.ethTxCache, oldHash)
+			delete(etp.cosmosTxCache, oldHash)
+			delete(etp.txTimes, oldHash)
+			delete(etp.announced, oldHash)
 		}
 
 		// Add new hash.
@@ -63,26 +108,52 @@ func (etp *EthTxPool) Insert(ctx context.Context, tx sdk.Tx) error {
 		}
 		etp.nonceToHash[sender][nonce] = newHash
 		etp.ethTxCache[newHash] = ethTx
+		etp.cosmosTxCache[newHash] = tx
+		etp.txTimes[newHash] = time.Now()
+
+		return etp.promote(sender), nil
 	}
 
-	return nil
+	return nil, nil
 }
 
-// Remove is called when a transaction is removed from the mempool.
+// Remove is called when a transaction is removed from the mempool. The queued txs of the sender
+// that become pending (e.g. because the nonce of the sender moved past a gap) are sent to the new
+// txs subscribers.
 func (etp *EthTxPool) Remove(tx sdk.Tx) error {
+	promoted, err := etp.remove(tx)
+	etp.announce(promoted)
+	return err
+}
+
+// remove removes the given transaction from the mempool and returns the promoted txs.
+func (etp *EthTxPool) remove(tx sdk.Tx) (coretypes.Transactions, error) {
 	etp.mu.Lock()
 	defer etp.mu.Unlock()
 
 	// Call the base mempool's Remove method
 	if err := etp.PriorityNonceMempool.Remove(tx); err != nil {
-		return err
+		return nil, err
 	}
 
 	// We want to remove any references to the tx from the cache.
 	if ethTx := evmtypes.GetAsEthTx(tx); ethTx != nil {
-		delete(etp.ethTxCache, ethTx.Hash())
-		delete(etp.nonceToHash[coretypes.GetSender(ethTx)], ethTx.Nonce())
+		etp.uncache(ethTx)
+		return etp.promote(coretypes.GetSender(ethTx)), nil
 	}
 
-	return nil
+	return nil, nil
+}
+
+// uncache removes any references to the given tx from the caches.
+func (etp *EthTxPool) uncache(ethTx *coretypes.Transaction) {
+	hash, sender := ethTx.Hash(), coretypes.GetSender(ethTx)
+	delete(etp.ethTxCache, hash)
+	delete(etp.cosmosTxCache, hash)
+	delete(etp.txTimes, hash)
+	delete(etp.announced, hash)
+	delete(etp.nonceToHash[sender], ethTx.Nonce())
+	if len(etp.nonceToHash[sender]) == 0 {
+		delete(etp.nonceToHash, sender)
+	}
 }
@@ -21,17 +21,22 @@
 package txpool
 
 import (
+	"sort"
+	"sync"
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/ethereum/go-ethereum/event"
-
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
 	mempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
+	ethtxpool "pkg.berachain.dev/polaris/eth/core/txpool"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	errorslib "pkg.berachain.dev/polaris/lib/errors"
 )
@@ -45,6 +50,10 @@ type Plugin interface {
 	core.TxPoolPlugin
 	SetNonceRetriever(mempool.NonceRetriever)
 	SetClientContext(client.Context)
+	SetConfig(ethtxpool.Config)
+	SetJournal(string, time.Duration, log.Logger)
+	StartJournal()
+	StopJournal() error
 }
 
 // plugin represents the transaction pool plugin.
@@ -53,57 +62,73 @@ type plugin struct {
 
 	clientContext client.Context
 
-	// txFeed and scope is used to send new batch transactions to new txs subscribers when the
-	// batch is added to the mempool.
-	txFeed event.Feed
-	scope  event.SubscriptionScope
+	// nr is used to re-validate the nonces of the journaled transactions.
+	nr mempool.NonceRetriever
+
+	// journal persists the local transactions (sent through this node) to disk, it is rotated
+	// every rejournal interval and is nil if journaling is disabled.
+	journal   *journal
+	rejournal time.Duration
+	logger    log.Logger
+	// locals is the set of senders of the local transactions.
+	locals map[common.Address]struct{}
+	// mu protects the journal and the locals.
+	mu sync.Mutex
+	// quit stops the rotation of the journal, which is done once `done` is closed.
+	quit chan struct{}
+	done chan struct{}
 }
 
 // NewPlugin returns a new transaction pool plugin.
 func NewPlugin(ethTxMempool *mempool.EthTxPool) Plugin {
 	return &plugin{
 		EthTxPool: ethTxMempool,
+		locals:    make(map[common.Address]struct{}),
+		logger:    log.NewNopLogger(),
 	}
 }
 
+// SetNonceRetriever implements the Plugin interface.
+func (p *plugin) SetNonceRetriever(nr mempool.NonceRetriever) {
+	p.nr = nr
+	p.EthTxPool.SetNonceRetriever(nr)
+}
+
 // SetClientContext implements the Plugin interface.
 func (p *plugin) SetClientContext(ctx client.Context) {
 	p.clientContext = ctx
 }
 
-// SubscribeNewTxsEvent returns a new event subscription for the new txs feed.
-func (p *plugin) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
-	return p.scope.Track(p.txFeed.Subscribe(ch))
-}
-
 // SendTx sends a transaction to the transaction pool. It takes in a signed Ethereum transaction
 // from the rpc backend and wraps it in a Cosmos transaction. The Cosmos transaction is then
-// broadcasted to the network.
+// broadcasted to the network. The new txs event is sent by the mempool once the transaction
+// enters its pending set.
 func (p *plugin) SendTx(signedEthTx *coretypes.Transaction) error {
+	if err := p.broadcastTx(signedEthTx); err != nil {
+		return err
+	}
+
+	// Journal the transaction, so that it survives restarts of the node.
+	p.journalTx(signedEthTx)
+	return nil
+}
+
+// broadcastTx wraps the given signed Ethereum transaction in a Cosmos transaction and sends it to
+// the CometBFT mempool, which checks it into the app mempool and gossips it to peers via
+// CometBFT's p2p layer.
+func (p *plugin) broadcastTx(signedEthTx *coretypes.Transaction) error {
 	// Serialize the transaction to Bytes
 	txBytes, err := SerializeToBytes(p.clientContext, signedEthTx)
 	if err != nil {
 		return errorslib.Wrap(err, "failed to serialize transaction")
 	}
 
-	// Send the transaction to the CometBFT mempool, which will gossip it to peers via CometBFT's
-	// p2p layer.
 	syncCtx := p.clientContext.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
 	if rsp != nil && rsp.Code != 0 {
 		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
 	}
-	if err != nil {
-		// b.logger.Error("failed to broadcast tx", "error", err.Errsor())
-		return err
-	}
-
-	// Currently sending an individual new txs event for every new tx added to the mempool via
-	// broadcast.
-	// TODO: support sending batch new txs events when adding queued txs to the pending txs.
-	// TODO: move to mempool?
-	p.txFeed.Send(core.NewTxsEvent{Txs: coretypes.Transactions{signedEthTx}})
-	return nil
+	return err
 }
 
 // SendPrivTx sends a private transaction to the transaction pool. It takes in a signed ethereum
@@ -118,7 +143,143 @@ func (p *plugin) SendPrivTx(signedTx *coretypes.Transaction) error {
 	// We insert into the local mempool, without gossiping to peers. We use a blank sdk.Context{}
 	// as the context, as we don't need to use it anyways. We set the priority as the gas price of
 	// the tx.
-	return p.EthTxPool.Insert(sdk.Context{}.WithPriority(signedTx.GasPrice().Int64()), cosmosTx)
+	if err = p.EthTxPool.Insert(
+		sdk.Context{}.WithPriority(signedTx.GasPrice().Int64()), cosmosTx,
+	); err != nil {
+		return err
+	}
+
+	// Journal the transaction, so that it survives restarts of the node.
+	p.journalTx(signedTx)
+	return nil
 }
 
 func (p *plugin) IsPlugin() {}
+
+// =============================================================================
+// Journal
+// =============================================================================
+
+// SetJournal enables journaling the local transactions at the given path, which is rotated every
+// rejournal interval. It must be called before `StartJournal`.
+func (p *plugin) SetJournal(path string, rejournal time.Duration, logger log.Logger) {
+	if rejournal < time.Second {
+		logger.Info("sanitizing invalid txpool journal time", "provided", rejournal, "updated", time.Second)
+		rejournal = time.Second
+	}
+	p.journal = newJournal(path)
+	p.rejournal = rejournal
+	p.logger = logger
+}
+
+// StartJournal re-broadcasts the journaled local transactions, after re-validating their nonces,
+// and starts rotating the journal until `StopJournal`. It is a no-op if journaling is disabled.
+func (p *plugin) StartJournal() {
+	if p.journal == nil {
+		return
+	}
+
+	p.mu.Lock()
+	total, dropped, err := p.journal.load(p.addJournaled)
+	if err != nil {
+		p.logger.Error("failed to load transaction journal", "err", err)
+	}
+	p.logger.Info("loaded local transaction journal", "transactions", total, "dropped", dropped)
+	if err = p.journal.rotate(p.local()); err != nil {
+		p.logger.Error("failed to rotate transaction journal", "err", err)
+	}
+	p.quit, p.done = make(chan struct{}), make(chan struct{})
+	p.mu.Unlock()
+
+	go p.loopJournal(p.quit, p.done)
+}
+
+// StopJournal stops rotating the journal and closes it, it is called when the node shuts down. It
+// is a no-op if the journal was not started.
+func (p *plugin) StopJournal() error {
+	p.mu.Lock()
+	quit, done := p.quit, p.done
+	p.quit, p.done = nil, nil
+	p.mu.Unlock()
+	if quit == nil {
+		return nil
+	}
+
+	close(quit)
+	<-done
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.journal.close()
+}
+
+// loopJournal periodically rotates the journal with the local transactions in the mempool, until
+// quit is closed.
+func (p *plugin) loopJournal(quit, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(p.rejournal)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.mu.Lock()
+			if err := p.journal.rotate(p.local()); err != nil {
+				p.logger.Error("failed to rotate local transaction journal", "err", err)
+			}
+			p.mu.Unlock()
+		case <-quit:
+			return
+		}
+	}
+}
+
+// addJournaled re-broadcasts the given journaled transactions, like `SendTx`, so that they are
+// checked into the mempool and gossiped to peers again. The ones whose nonce is lower than the
+// nonce of their sender are dropped. It returns an error for every transaction that could not be
+// broadcasted.
+func (p *plugin) addJournaled(txs coretypes.Transactions) []error {
+	errs := make([]error, len(txs))
+	for i, tx := range txs {
+		sender := coretypes.GetSender(tx)
+		if p.nr != nil && tx.Nonce() < p.nr.GetNonce(sender) {
+			errs[i] = core.ErrNonceTooLow
+			continue
+		}
+
+		if errs[i] = p.broadcastTx(tx); errs[i] == nil {
+			p.locals[sender] = struct{}{}
+		}
+	}
+	return errs
+}
+
+// journalTx marks the sender of the given transaction as local and adds the transaction to the
+// journal, if journaling is enabled.
+func (p *plugin) journalTx(tx *coretypes.Transaction) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.locals[coretypes.GetSender(tx)] = struct{}{}
+	if p.journal == nil {
+		return
+	}
+	if err := p.journal.insert(tx); err != nil {
+		p.logger.Error("failed to journal local transaction", "err", err)
+	}
+}
+
+// local returns the pending and queued transactions of the local senders, sorted by nonce.
+func (p *plugin) local() map[common.Address]coretypes.Transactions {
+	pending, queued := p.Content()
+	txs := make(map[common.Address]coretypes.Transactions)
+	for addr := range p.locals {
+		all := append(pending[addr], queued[addr]...) //nolint:gocritic // new slice.
+		if len(all) == 0 {
+			continue
+		}
+		sort.Slice(all, func(i, j int) bool { return all[i].Nonce() < all[j].Nonce() })
+		txs[addr] = all
+	}
+	return txs
+}
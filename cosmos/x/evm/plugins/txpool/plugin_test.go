@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	cryptocodec "pkg.berachain.dev/polaris/cosmos/crypto/codec"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethtypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin journal", func() {
+	var (
+		p    *plugin
+		node *mockCometRPC
+		path string
+		txs  ethtypes.Transactions
+	)
+
+	BeforeEach(func() {
+		encCfg := moduletestutil.MakeTestEncodingConfig()
+		types.RegisterInterfaces(encCfg.InterfaceRegistry)
+		cryptocodec.RegisterInterfaces(encCfg.InterfaceRegistry)
+		node = &mockCometRPC{}
+
+		p = NewPlugin(mempool.NewPolarisEthereumTxPool()).(*plugin)
+		p.SetClientContext(client.Context{}.WithTxConfig(encCfg.TxConfig).WithClient(node))
+		path = filepath.Join(GinkgoT().TempDir(), "transactions.rlp")
+		p.SetJournal(path, time.Second, log.NewNopLogger())
+
+		// journal two local transactions of a sender.
+		key, err := crypto.GenerateEthKey()
+		Expect(err).ToNot(HaveOccurred())
+		signer := ethtypes.LatestSignerForChainID(big.NewInt(2061))
+		txs = ethtypes.Transactions{}
+		for i := uint64(0); i < 2; i++ {
+			txs = append(txs, ethtypes.MustSignNewTx(key, signer, &ethtypes.LegacyTx{
+				Nonce: i, To: &common.Address{0x1}, Gas: 21000, GasPrice: big.NewInt(1),
+			}))
+		}
+		j := newJournal(path)
+		Expect(j.rotate(map[common.Address]ethtypes.Transactions{
+			crypto.PubkeyToAddress(key.PublicKey): txs,
+		})).To(Succeed())
+		Expect(j.close()).To(Succeed())
+	})
+
+	It("should re-broadcast the journaled transactions", func() {
+		p.StartJournal()
+		Expect(p.StopJournal()).To(Succeed())
+
+		Expect(node.broadcast).To(HaveLen(2))
+		for i, tx := range txs {
+			bz, err := SerializeToBytes(p.clientContext, tx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect([]byte(node.broadcast[i])).To(Equal(bz))
+		}
+	})
+
+	It("should not re-broadcast the journaled transactions with a nonce too low", func() {
+		p.SetNonceRetriever(mockNonceRetriever(1))
+		p.StartJournal()
+		Expect(p.StopJournal()).To(Succeed())
+
+		Expect(node.broadcast).To(HaveLen(1))
+		bz, err := SerializeToBytes(p.clientContext, txs[1])
+		Expect(err).ToNot(HaveOccurred())
+		Expect([]byte(node.broadcast[0])).To(Equal(bz))
+	})
+
+	It("should stop rotating and close the journal", func() {
+		p.StartJournal()
+		Expect(p.StopJournal()).To(Succeed())
+		Expect(p.journal.insert(txs[0])).To(MatchError(errNoActiveJournal))
+
+		// stopping again is a no-op.
+		Expect(p.StopJournal()).To(Succeed())
+	})
+})
+
+// mockCometRPC records the broadcast transactions.
+type mockCometRPC struct {
+	clitestutil.MockCometRPC
+	broadcast []cmttypes.Tx
+}
+
+func (m *mockCometRPC) BroadcastTxSync(
+	_ context.Context, tx cmttypes.Tx,
+) (*coretypes.ResultBroadcastTx, error) {
+	m.broadcast = append(m.broadcast, tx)
+	return &coretypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+// mockNonceRetriever returns the same nonce for every address.
+type mockNonceRetriever uint64
+
+func (m mockNonceRetriever) GetNonce(common.Address) uint64 {
+	return uint64(m)
+}
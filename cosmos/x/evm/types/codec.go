@@ -31,6 +31,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&WrappedEthereumTransaction{},
+		&MsgUpdateChainConfig{},
+		&MsgUpdateParams{},
+		&MsgActivateDynamicPrecompile{},
+		&MsgDeactivateDynamicPrecompile{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_MsgService_serviceDesc)
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+)
+
+const (
+	// paramsGenesisKey is the key of the parameters of the x/evm module in the genesis JSON.
+	paramsGenesisKey = "params"
+	// dynamicPrecompilesGenesisKey is the key of the dynamic precompiles activated by governance
+	// in the genesis JSON.
+	dynamicPrecompilesGenesisKey = "dynamic_precompiles"
+)
+
+// GenesisState is the genesis state of the x/evm module. It is encoded as the JSON of the Ethereum
+// genesis, with the parameters and the dynamic precompiles of the module as additional fields.
+type GenesisState struct {
+	// Ethereum is the genesis of the Polaris EVM.
+	Ethereum *core.Genesis
+	// Params are the parameters of the x/evm module.
+	Params *Params
+	// DynamicPrecompiles are the dynamic precompiles activated by governance, including the ones
+	// that are not active yet or anymore.
+	DynamicPrecompiles []*DynamicPrecompile
+}
+
+// DefaultGenesisState returns the default genesis state of the x/evm module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Ethereum: core.DefaultGenesis,
+		Params:   DefaultParams(),
+	}
+}
+
+// ValidateBasic is used to validate the genesis state.
+func (gs *GenesisState) ValidateBasic() error {
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+	seen := make(map[common.Address]struct{}, len(gs.DynamicPrecompiles))
+	for _, dp := range gs.DynamicPrecompiles {
+		if err := dp.ValidateBasic(); err != nil {
+			return err
+		}
+		addr := common.HexToAddress(dp.Address)
+		if _, found := seen[addr]; found {
+			return fmt.Errorf("duplicate dynamic precompile address %s", dp.Address)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+// MarshalJSON implements `json.Marshaler`.
+func (gs *GenesisState) MarshalJSON() ([]byte, error) {
+	bz, err := gs.Ethereum.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	if fields[paramsGenesisKey], err = json.Marshal(gs.Params); err != nil {
+		return nil, err
+	}
+	if len(gs.DynamicPrecompiles) > 0 {
+		if fields[dynamicPrecompilesGenesisKey], err = json.Marshal(gs.DynamicPrecompiles); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements `json.Unmarshaler`. The parameters default to `DefaultParams` if they
+// are not set, so that a plain Ethereum genesis is a valid genesis state.
+func (gs *GenesisState) UnmarshalJSON(bz []byte) error {
+	ethGen := new(core.Genesis)
+	if err := ethGen.UnmarshalJSON(bz); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return err
+	}
+	params := DefaultParams()
+	if paramsBz, ok := fields[paramsGenesisKey]; ok {
+		if err := json.Unmarshal(paramsBz, params); err != nil {
+			return err
+		}
+	}
+	var dps []*DynamicPrecompile
+	if dpsBz, ok := fields[dynamicPrecompilesGenesisKey]; ok {
+		if err := json.Unmarshal(dpsBz, &dps); err != nil {
+			return err
+		}
+	}
+	gs.Ethereum, gs.Params, gs.DynamicPrecompiles = ethGen, params, dps
+	return nil
+}
@@ -35,4 +35,8 @@ const (
 	GenesisHeaderKey
 	ParamsKey
 	ChainConfigPrefix
+	EarliestBlockNumKey
+	PrunedTxHashKeyPrefix
+	DynamicPrecompileKeyPrefix
+	PrunedBlockNumKeyToHashPrefix
 )
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// DefaultParams contains the default values for all parameters.
+func DefaultParams() *Params {
+	return &Params{
+		AccessControlMode: AccessControlMode_ACCESS_CONTROL_MODE_OPEN,
+	}
+}
+
+// ValidateBasic is used to validate the parameters.
+func (p *Params) ValidateBasic() error {
+	if p == nil {
+		return errors.New("params cannot be nil")
+	}
+	if _, ok := AccessControlMode_name[int32(p.AccessControlMode)]; !ok {
+		return fmt.Errorf("invalid access control mode %d", p.AccessControlMode)
+	}
+	for _, addr := range p.Allowlist {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid allowlisted address %s", addr)
+		}
+	}
+	return nil
+}
+
+// CanSendTransaction returns whether the given account is allowed to send EVM transactions by the
+// access control mode.
+func (p *Params) CanSendTransaction(addr common.Address) bool {
+	return p.AccessControlMode != AccessControlMode_ACCESS_CONTROL_MODE_FULL_ALLOWLIST ||
+		p.isAllowlisted(addr)
+}
+
+// CanCreateContract returns whether the given account is allowed to create contracts by the access
+// control mode.
+func (p *Params) CanCreateContract(addr common.Address) bool {
+	return p.AccessControlMode == AccessControlMode_ACCESS_CONTROL_MODE_OPEN ||
+		p.isAllowlisted(addr)
+}
+
+// isAllowlisted returns whether the given account is in the allowlist.
+func (p *Params) isAllowlisted(addr common.Address) bool {
+	for _, allowed := range p.Allowlist {
+		if common.HexToAddress(allowed) == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,400 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: polaris/evm/v1alpha1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// `AccessControlMode` defines the accounts that can send EVM transactions and create contracts.
+type AccessControlMode int32
+
+const (
+	// `ACCESS_CONTROL_MODE_OPEN` allows any account to send transactions and create contracts.
+	AccessControlMode_ACCESS_CONTROL_MODE_OPEN AccessControlMode = 0
+	// `ACCESS_CONTROL_MODE_DEPLOYER_ALLOWLIST` allows any account to send transactions, but only the
+	// allowlisted accounts to create contracts.
+	AccessControlMode_ACCESS_CONTROL_MODE_DEPLOYER_ALLOWLIST AccessControlMode = 1
+	// `ACCESS_CONTROL_MODE_FULL_ALLOWLIST` allows only the allowlisted accounts to send transactions
+	// and create contracts.
+	AccessControlMode_ACCESS_CONTROL_MODE_FULL_ALLOWLIST AccessControlMode = 2
+)
+
+var AccessControlMode_name = map[int32]string{
+	0: "ACCESS_CONTROL_MODE_OPEN",
+	1: "ACCESS_CONTROL_MODE_DEPLOYER_ALLOWLIST",
+	2: "ACCESS_CONTROL_MODE_FULL_ALLOWLIST",
+}
+
+var AccessControlMode_value = map[string]int32{
+	"ACCESS_CONTROL_MODE_OPEN":               0,
+	"ACCESS_CONTROL_MODE_DEPLOYER_ALLOWLIST": 1,
+	"ACCESS_CONTROL_MODE_FULL_ALLOWLIST":     2,
+}
+
+func (x AccessControlMode) String() string {
+	return proto.EnumName(AccessControlMode_name, int32(x))
+}
+
+func (AccessControlMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9f6c2eac5100e18c, []int{0}
+}
+
+// `Params` defines the parameters for the x/evm module.
+type Params struct {
+	// `access_control_mode` defines the accounts that can send transactions and create contracts.
+	AccessControlMode AccessControlMode `protobuf:"varint,1,opt,name=access_control_mode,json=accessControlMode,proto3,enum=polaris.evm.v1alpha1.AccessControlMode" json:"access_control_mode,omitempty"`
+	// `allowlist` contains the hex addresses of the accounts allowed by the access control mode.
+	Allowlist []string `protobuf:"bytes,2,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f6c2eac5100e18c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAccessControlMode() AccessControlMode {
+	if m != nil {
+		return m.AccessControlMode
+	}
+	return AccessControlMode_ACCESS_CONTROL_MODE_OPEN
+}
+
+func (m *Params) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("polaris.evm.v1alpha1.AccessControlMode", AccessControlMode_name, AccessControlMode_value)
+	proto.RegisterType((*Params)(nil), "polaris.evm.v1alpha1.Params")
+}
+
+func init() { proto.RegisterFile("polaris/evm/v1alpha1/params.proto", fileDescriptor_9f6c2eac5100e18c) }
+
+var fileDescriptor_9f6c2eac5100e18c = []byte{
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd0, 0xb1, 0x4a, 0xc3, 0x40,
+	0x1c, 0xc7, 0xf1, 0x5c, 0x85, 0x42, 0x6f, 0x90, 0x36, 0x3a, 0x74, 0x90, 0xa3, 0x76, 0xa8, 0xa5,
+	0xc8, 0x85, 0xea, 0x13, 0xc4, 0x34, 0x05, 0xe1, 0xda, 0x2b, 0x69, 0xa5, 0xe8, 0x72, 0x5c, 0xd3,
+	0xc3, 0x06, 0x2f, 0xbd, 0x23, 0x17, 0xa2, 0x4e, 0x4e, 0xee, 0x3e, 0x96, 0x63, 0x47, 0x47, 0x49,
+	0x5e, 0x44, 0x88, 0x16, 0x45, 0xb2, 0xfe, 0xff, 0x9f, 0xdf, 0xf2, 0x85, 0xa7, 0x5a, 0x49, 0x9e,
+	0x44, 0xc6, 0x11, 0x59, 0xec, 0x64, 0x43, 0x2e, 0xf5, 0x86, 0x0f, 0x1d, 0xcd, 0x13, 0x1e, 0x1b,
+	0xac, 0x13, 0x95, 0x2a, 0xfb, 0xf8, 0x87, 0x60, 0x91, 0xc5, 0x78, 0x4f, 0xba, 0x2f, 0xb0, 0x3e,
+	0x2b, 0x95, 0xbd, 0x84, 0x47, 0x3c, 0x0c, 0x85, 0x31, 0x2c, 0x54, 0xdb, 0x34, 0x51, 0x92, 0xc5,
+	0x6a, 0x2d, 0xda, 0xa0, 0x03, 0xfa, 0x87, 0x17, 0x67, 0xb8, 0x6a, 0x8d, 0xdd, 0x72, 0xe0, 0x7d,
+	0xfb, 0x89, 0x5a, 0x8b, 0xa0, 0xc5, 0xff, 0x9f, 0xec, 0x13, 0xd8, 0xe0, 0x52, 0xaa, 0x47, 0x19,
+	0x99, 0xb4, 0x5d, 0xeb, 0x1c, 0xf4, 0x1b, 0xc1, 0xef, 0x61, 0xf0, 0x0a, 0x60, 0xcb, 0xad, 0xd8,
+	0xb4, 0x5d, 0xcf, 0xf3, 0xe7, 0x73, 0xe6, 0xd1, 0xe9, 0x22, 0xa0, 0x84, 0x4d, 0xe8, 0xc8, 0x67,
+	0x74, 0xe6, 0x4f, 0x9b, 0x96, 0x3d, 0x80, 0xbd, 0xaa, 0xef, 0xc8, 0x9f, 0x11, 0x7a, 0xeb, 0x07,
+	0xcc, 0x25, 0x84, 0x2e, 0xc9, 0xf5, 0x7c, 0xd1, 0x04, 0x76, 0x0f, 0x76, 0xab, 0xec, 0xf8, 0x86,
+	0x90, 0x3f, 0xae, 0x76, 0x35, 0x7e, 0xcf, 0x11, 0xd8, 0xe5, 0x08, 0x7c, 0xe6, 0x08, 0xbc, 0x15,
+	0xc8, 0xda, 0x15, 0xc8, 0xfa, 0x28, 0x90, 0x75, 0x77, 0xae, 0x1f, 0xee, 0xf1, 0x4a, 0x24, 0x3c,
+	0xdc, 0xf0, 0x68, 0x8b, 0xd7, 0x22, 0x73, 0xf6, 0xb5, 0x43, 0x65, 0x62, 0x65, 0x9c, 0xa7, 0x32,
+	0x7b, 0xfa, 0xac, 0x85, 0x59, 0xd5, 0xcb, 0xda, 0x97, 0x5f, 0x03, 0x00, 0x52, 0x44, 0x02, 0xa4,
+	0x92, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AccessControlMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccessControlMode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccessControlMode != 0 {
+		n += 1 + sovParams(uint64(m.AccessControlMode))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControlMode", wireType)
+			}
+			m.AccessControlMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessControlMode |= AccessControlMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
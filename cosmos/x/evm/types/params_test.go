@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Params", func() {
+	var (
+		allowed = common.HexToAddress("0x1")
+		other   = common.HexToAddress("0x2")
+	)
+
+	It("should validate the params", func() {
+		Expect(types.DefaultParams().ValidateBasic()).To(Succeed())
+		Expect((&types.Params{Allowlist: []string{"invalid"}}).ValidateBasic()).ToNot(Succeed())
+		Expect((&types.Params{AccessControlMode: 3}).ValidateBasic()).ToNot(Succeed())
+	})
+
+	It("should allow any account in the open mode", func() {
+		p := types.DefaultParams()
+		Expect(p.CanSendTransaction(other)).To(BeTrue())
+		Expect(p.CanCreateContract(other)).To(BeTrue())
+	})
+
+	It("should allow only the allowlisted accounts to create contracts", func() {
+		p := &types.Params{
+			AccessControlMode: types.AccessControlMode_ACCESS_CONTROL_MODE_DEPLOYER_ALLOWLIST,
+			Allowlist:         []string{allowed.Hex()},
+		}
+		Expect(p.CanSendTransaction(other)).To(BeTrue())
+		Expect(p.CanCreateContract(other)).To(BeFalse())
+		Expect(p.CanCreateContract(allowed)).To(BeTrue())
+	})
+
+	It("should allow only the allowlisted accounts to send transactions", func() {
+		p := &types.Params{
+			AccessControlMode: types.AccessControlMode_ACCESS_CONTROL_MODE_FULL_ALLOWLIST,
+			Allowlist:         []string{allowed.Hex()},
+		}
+		Expect(p.CanSendTransaction(other)).To(BeFalse())
+		Expect(p.CanCreateContract(other)).To(BeFalse())
+		Expect(p.CanSendTransaction(allowed)).To(BeTrue())
+		Expect(p.CanCreateContract(allowed)).To(BeTrue())
+	})
+})
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// ValidateBasic is used to validate the dynamic precompile.
+func (dp *DynamicPrecompile) ValidateBasic() error {
+	if dp.Name == "" {
+		return errors.New("dynamic precompile name cannot be empty")
+	}
+	if !common.IsHexAddress(dp.Address) {
+		return fmt.Errorf("invalid dynamic precompile address %s", dp.Address)
+	}
+	if dp.DeactivationHeight != 0 && dp.DeactivationHeight <= dp.ActivationHeight {
+		return fmt.Errorf(
+			"deactivation height %d must be greater than activation height %d",
+			dp.DeactivationHeight, dp.ActivationHeight,
+		)
+	}
+	return nil
+}
+
+// IsActive returns whether the dynamic precompile is active at the block of the given height.
+func (dp *DynamicPrecompile) IsActive(height uint64) bool {
+	return height >= dp.ActivationHeight &&
+		(dp.DeactivationHeight == 0 || height < dp.DeactivationHeight)
+}
@@ -0,0 +1,450 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: polaris/evm/v1alpha1/precompile.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// `DynamicPrecompile` defines a precompile of the host chain binary that is activated at an address
+// by governance, instead of when the host chain is constructed.
+type DynamicPrecompile struct {
+	// `name` is the name of the dynamic precompile in the host chain binary.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// `address` is the hex address at which the dynamic precompile is active.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// `activation_height` is the height of the first block at which the dynamic precompile is
+	// active.
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// `deactivation_height` is the height of the first block at which the dynamic precompile is no
+	// longer active, or 0 if it is not scheduled to be deactivated.
+	DeactivationHeight uint64 `protobuf:"varint,4,opt,name=deactivation_height,json=deactivationHeight,proto3" json:"deactivation_height,omitempty"`
+}
+
+func (m *DynamicPrecompile) Reset()         { *m = DynamicPrecompile{} }
+func (m *DynamicPrecompile) String() string { return proto.CompactTextString(m) }
+func (*DynamicPrecompile) ProtoMessage()    {}
+func (*DynamicPrecompile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda802b209099183, []int{0}
+}
+func (m *DynamicPrecompile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicPrecompile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicPrecompile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicPrecompile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicPrecompile.Merge(m, src)
+}
+func (m *DynamicPrecompile) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicPrecompile) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicPrecompile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicPrecompile proto.InternalMessageInfo
+
+func (m *DynamicPrecompile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DynamicPrecompile) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DynamicPrecompile) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *DynamicPrecompile) GetDeactivationHeight() uint64 {
+	if m != nil {
+		return m.DeactivationHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DynamicPrecompile)(nil), "polaris.evm.v1alpha1.DynamicPrecompile")
+}
+
+func init() {
+	proto.RegisterFile("polaris/evm/v1alpha1/precompile.proto", fileDescriptor_eda802b209099183)
+}
+
+var fileDescriptor_eda802b209099183 = []byte{
+	// 238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x31, 0x4a, 0x04, 0x31,
+	0x14, 0x86, 0x27, 0x3a, 0x28, 0xa6, 0x72, 0xa3, 0xc5, 0x54, 0x61, 0x11, 0x84, 0x05, 0x25, 0x61,
+	0xf1, 0x06, 0x22, 0x62, 0x29, 0x5b, 0xda, 0xc8, 0xdb, 0xe4, 0xb1, 0x13, 0x9c, 0x4c, 0x42, 0x12,
+	0x82, 0x7b, 0x0b, 0x6f, 0xe0, 0x75, 0x2c, 0xb7, 0xb4, 0x94, 0x99, 0x8b, 0x08, 0xc1, 0x51, 0x70,
+	0xbb, 0xf7, 0xfe, 0xff, 0xfb, 0x9b, 0x8f, 0x5e, 0x7a, 0xd7, 0x41, 0x30, 0x51, 0x62, 0xb6, 0x32,
+	0x2f, 0xa1, 0xf3, 0x2d, 0x2c, 0xa5, 0x0f, 0xa8, 0x9c, 0xf5, 0xa6, 0x43, 0xe1, 0x83, 0x4b, 0x8e,
+	0x9d, 0xff, 0x60, 0x02, 0xb3, 0x15, 0x13, 0x76, 0xf1, 0x4e, 0xe8, 0xec, 0x6e, 0xdb, 0x83, 0x35,
+	0xea, 0xf1, 0x77, 0xc1, 0x18, 0xad, 0x7b, 0xb0, 0xd8, 0x90, 0x39, 0x59, 0x9c, 0xac, 0xca, 0xcd,
+	0x1a, 0x7a, 0x0c, 0x5a, 0x07, 0x8c, 0xb1, 0x39, 0x28, 0xf1, 0xf4, 0xb2, 0x2b, 0x3a, 0x03, 0x95,
+	0x4c, 0x86, 0x64, 0x5c, 0xff, 0xdc, 0xa2, 0xd9, 0xb4, 0xa9, 0x39, 0x9c, 0x93, 0x45, 0xbd, 0x3a,
+	0xfd, 0x2b, 0x1e, 0x4a, 0xce, 0x24, 0x3d, 0xd3, 0xb8, 0x8f, 0xd7, 0x05, 0x67, 0x1a, 0xff, 0x0f,
+	0x6e, 0xef, 0x3f, 0x06, 0x4e, 0x76, 0x03, 0x27, 0x5f, 0x03, 0x27, 0x6f, 0x23, 0xaf, 0x76, 0x23,
+	0xaf, 0x3e, 0x47, 0x5e, 0x3d, 0x5d, 0xfb, 0x97, 0x8d, 0x58, 0x63, 0x00, 0xd5, 0x82, 0xe9, 0x85,
+	0xc6, 0x2c, 0x27, 0x15, 0xca, 0x45, 0xeb, 0xa2, 0x7c, 0x2d, 0x4e, 0xd2, 0xd6, 0x63, 0x5c, 0x1f,
+	0x15, 0x0d, 0x37, 0xdf, 0x03, 0x00, 0x38, 0x8f, 0x4a, 0xc8, 0x2f, 0x01, 0x00, 0x00,
+}
+
+func (m *DynamicPrecompile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicPrecompile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicPrecompile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeactivationHeight != 0 {
+		i = encodeVarintPrecompile(dAtA, i, uint64(m.DeactivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintPrecompile(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPrecompile(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPrecompile(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPrecompile(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPrecompile(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicPrecompile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPrecompile(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPrecompile(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovPrecompile(uint64(m.ActivationHeight))
+	}
+	if m.DeactivationHeight != 0 {
+		n += 1 + sovPrecompile(uint64(m.DeactivationHeight))
+	}
+	return n
+}
+
+func sovPrecompile(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPrecompile(x uint64) (n int) {
+	return sovPrecompile(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicPrecompile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrecompile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicPrecompile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicPrecompile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrecompile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrecompile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrecompile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrecompile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrecompile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrecompile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrecompile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivationHeight", wireType)
+			}
+			m.DeactivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrecompile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeactivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrecompile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrecompile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPrecompile(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPrecompile
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrecompile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrecompile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPrecompile
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPrecompile
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPrecompile
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPrecompile        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPrecompile          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPrecompile = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DynamicPrecompile", func() {
+	addr := common.HexToAddress("0x69").Hex()
+
+	It("should validate the dynamic precompile", func() {
+		Expect((&types.DynamicPrecompile{Name: "p", Address: addr}).ValidateBasic()).To(Succeed())
+		Expect((&types.DynamicPrecompile{Address: addr}).ValidateBasic()).ToNot(Succeed())
+		Expect((&types.DynamicPrecompile{Name: "p", Address: "invalid"}).ValidateBasic()).
+			ToNot(Succeed())
+		Expect((&types.DynamicPrecompile{
+			Name: "p", Address: addr, ActivationHeight: 2, DeactivationHeight: 2,
+		}).ValidateBasic()).ToNot(Succeed())
+	})
+
+	It("should be active between the activation and deactivation heights", func() {
+		dp := &types.DynamicPrecompile{Name: "p", Address: addr, ActivationHeight: 2}
+		Expect(dp.IsActive(1)).To(BeFalse())
+		Expect(dp.IsActive(2)).To(BeTrue())
+		Expect(dp.IsActive(100)).To(BeTrue())
+
+		dp.DeactivationHeight = 4
+		Expect(dp.IsActive(3)).To(BeTrue())
+		Expect(dp.IsActive(4)).To(BeFalse())
+	})
+})
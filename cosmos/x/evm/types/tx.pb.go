@@ -138,36 +138,487 @@ func (m *WrappedEthereumTransactionResult) GetReturnData() []byte {
 	return nil
 }
 
+// MsgUpdateChainConfig is the Msg/UpdateChainConfig request type.
+type MsgUpdateChainConfig struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// `chain_config` is the JSON-encoded Ethereum chain config, which takes effect from the next
+	// block. The hard forks that are already activated cannot be changed.
+	ChainConfig string `protobuf:"bytes,2,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty"`
+}
+
+func (m *MsgUpdateChainConfig) Reset()         { *m = MsgUpdateChainConfig{} }
+func (m *MsgUpdateChainConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainConfig) ProtoMessage()    {}
+func (*MsgUpdateChainConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{2}
+}
+func (m *MsgUpdateChainConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChainConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateChainConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainConfig.Merge(m, src)
+}
+func (m *MsgUpdateChainConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateChainConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateChainConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateChainConfig) GetChainConfig() string {
+	if m != nil {
+		return m.ChainConfig
+	}
+	return ""
+}
+
+// MsgUpdateChainConfigResponse defines the response structure for executing a
+// MsgUpdateChainConfig message.
+type MsgUpdateChainConfigResponse struct {
+}
+
+func (m *MsgUpdateChainConfigResponse) Reset()         { *m = MsgUpdateChainConfigResponse{} }
+func (m *MsgUpdateChainConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateChainConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{3}
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateChainConfigResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/evm parameters to update. NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgActivateDynamicPrecompile is the Msg/ActivateDynamicPrecompile request type.
+type MsgActivateDynamicPrecompile struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// `name` is the name of the dynamic precompile in the host chain binary.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// `address` is the hex address at which the dynamic precompile is activated. It must not be the
+	// address of another precompile.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// `activation_height` is the height of the first block at which the dynamic precompile is
+	// active. It must be greater than the height of the block of the message.
+	ActivationHeight uint64 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *MsgActivateDynamicPrecompile) Reset()         { *m = MsgActivateDynamicPrecompile{} }
+func (m *MsgActivateDynamicPrecompile) String() string { return proto.CompactTextString(m) }
+func (*MsgActivateDynamicPrecompile) ProtoMessage()    {}
+func (*MsgActivateDynamicPrecompile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{6}
+}
+func (m *MsgActivateDynamicPrecompile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgActivateDynamicPrecompile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgActivateDynamicPrecompile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgActivateDynamicPrecompile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgActivateDynamicPrecompile.Merge(m, src)
+}
+func (m *MsgActivateDynamicPrecompile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgActivateDynamicPrecompile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgActivateDynamicPrecompile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgActivateDynamicPrecompile proto.InternalMessageInfo
+
+func (m *MsgActivateDynamicPrecompile) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgActivateDynamicPrecompile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgActivateDynamicPrecompile) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgActivateDynamicPrecompile) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// MsgActivateDynamicPrecompileResponse defines the response structure for executing a
+// MsgActivateDynamicPrecompile message.
+type MsgActivateDynamicPrecompileResponse struct {
+	// `precompile` is the dynamic precompile that is scheduled to be activated.
+	Precompile *DynamicPrecompile `protobuf:"bytes,1,opt,name=precompile,proto3" json:"precompile,omitempty"`
+}
+
+func (m *MsgActivateDynamicPrecompileResponse) Reset()         { *m = MsgActivateDynamicPrecompileResponse{} }
+func (m *MsgActivateDynamicPrecompileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgActivateDynamicPrecompileResponse) ProtoMessage()    {}
+func (*MsgActivateDynamicPrecompileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{7}
+}
+func (m *MsgActivateDynamicPrecompileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgActivateDynamicPrecompileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgActivateDynamicPrecompileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgActivateDynamicPrecompileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgActivateDynamicPrecompileResponse.Merge(m, src)
+}
+func (m *MsgActivateDynamicPrecompileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgActivateDynamicPrecompileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgActivateDynamicPrecompileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgActivateDynamicPrecompileResponse proto.InternalMessageInfo
+
+func (m *MsgActivateDynamicPrecompileResponse) GetPrecompile() *DynamicPrecompile {
+	if m != nil {
+		return m.Precompile
+	}
+	return nil
+}
+
+// MsgDeactivateDynamicPrecompile is the Msg/DeactivateDynamicPrecompile request type.
+type MsgDeactivateDynamicPrecompile struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// `address` is the hex address of the dynamic precompile to deactivate.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// `deactivation_height` is the height of the first block at which the dynamic precompile is no
+	// longer active. It must be greater than the height of the block of the message.
+	DeactivationHeight uint64 `protobuf:"varint,3,opt,name=deactivation_height,json=deactivationHeight,proto3" json:"deactivation_height,omitempty"`
+}
+
+func (m *MsgDeactivateDynamicPrecompile) Reset()         { *m = MsgDeactivateDynamicPrecompile{} }
+func (m *MsgDeactivateDynamicPrecompile) String() string { return proto.CompactTextString(m) }
+func (*MsgDeactivateDynamicPrecompile) ProtoMessage()    {}
+func (*MsgDeactivateDynamicPrecompile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{8}
+}
+func (m *MsgDeactivateDynamicPrecompile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeactivateDynamicPrecompile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeactivateDynamicPrecompile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeactivateDynamicPrecompile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeactivateDynamicPrecompile.Merge(m, src)
+}
+func (m *MsgDeactivateDynamicPrecompile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeactivateDynamicPrecompile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeactivateDynamicPrecompile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeactivateDynamicPrecompile proto.InternalMessageInfo
+
+func (m *MsgDeactivateDynamicPrecompile) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeactivateDynamicPrecompile) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgDeactivateDynamicPrecompile) GetDeactivationHeight() uint64 {
+	if m != nil {
+		return m.DeactivationHeight
+	}
+	return 0
+}
+
+// MsgDeactivateDynamicPrecompileResponse defines the response structure for executing a
+// MsgDeactivateDynamicPrecompile message.
+type MsgDeactivateDynamicPrecompileResponse struct {
+	// `precompile` is the dynamic precompile that is scheduled to be deactivated.
+	Precompile *DynamicPrecompile `protobuf:"bytes,1,opt,name=precompile,proto3" json:"precompile,omitempty"`
+}
+
+func (m *MsgDeactivateDynamicPrecompileResponse) Reset() {
+	*m = MsgDeactivateDynamicPrecompileResponse{}
+}
+func (m *MsgDeactivateDynamicPrecompileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeactivateDynamicPrecompileResponse) ProtoMessage()    {}
+func (*MsgDeactivateDynamicPrecompileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{9}
+}
+func (m *MsgDeactivateDynamicPrecompileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeactivateDynamicPrecompileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeactivateDynamicPrecompileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeactivateDynamicPrecompileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeactivateDynamicPrecompileResponse.Merge(m, src)
+}
+func (m *MsgDeactivateDynamicPrecompileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeactivateDynamicPrecompileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeactivateDynamicPrecompileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeactivateDynamicPrecompileResponse proto.InternalMessageInfo
+
+func (m *MsgDeactivateDynamicPrecompileResponse) GetPrecompile() *DynamicPrecompile {
+	if m != nil {
+		return m.Precompile
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WrappedEthereumTransaction)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransaction")
 	proto.RegisterType((*WrappedEthereumTransactionResult)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransactionResult")
+	proto.RegisterType((*MsgUpdateChainConfig)(nil), "polaris.evm.v1alpha1.MsgUpdateChainConfig")
+	proto.RegisterType((*MsgUpdateChainConfigResponse)(nil), "polaris.evm.v1alpha1.MsgUpdateChainConfigResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "polaris.evm.v1alpha1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "polaris.evm.v1alpha1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgActivateDynamicPrecompile)(nil), "polaris.evm.v1alpha1.MsgActivateDynamicPrecompile")
+	proto.RegisterType((*MsgActivateDynamicPrecompileResponse)(nil), "polaris.evm.v1alpha1.MsgActivateDynamicPrecompileResponse")
+	proto.RegisterType((*MsgDeactivateDynamicPrecompile)(nil), "polaris.evm.v1alpha1.MsgDeactivateDynamicPrecompile")
+	proto.RegisterType((*MsgDeactivateDynamicPrecompileResponse)(nil), "polaris.evm.v1alpha1.MsgDeactivateDynamicPrecompileResponse")
 }
 
 func init() { proto.RegisterFile("polaris/evm/v1alpha1/tx.proto", fileDescriptor_d8b33d2a2c64400f) }
 
 var fileDescriptor_d8b33d2a2c64400f = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xbd, 0x4a, 0x03, 0x41,
-	0x14, 0x85, 0x33, 0x1a, 0x8d, 0x8e, 0x62, 0xb1, 0x08, 0xc6, 0x05, 0xd7, 0x90, 0x2a, 0x88, 0xcc,
-	0x26, 0x0a, 0x3e, 0x80, 0x18, 0x3b, 0x9b, 0x55, 0x11, 0x6c, 0xc2, 0xcd, 0xee, 0x65, 0x77, 0x31,
-	0xb3, 0x33, 0xcc, 0xcc, 0x0e, 0x89, 0x95, 0x6f, 0xa0, 0x8f, 0xe2, 0x63, 0x58, 0xa6, 0xb4, 0x94,
-	0xa4, 0xf0, 0x35, 0x24, 0x93, 0x04, 0x2c, 0x4c, 0x61, 0x35, 0x3f, 0xe7, 0x1e, 0xee, 0x39, 0x7c,
-	0xf4, 0x48, 0x8a, 0x01, 0xa8, 0x5c, 0x87, 0x68, 0x79, 0x68, 0x3b, 0x30, 0x90, 0x19, 0x74, 0x42,
-	0x33, 0x64, 0x52, 0x09, 0x23, 0xbc, 0xfd, 0x85, 0xcc, 0xd0, 0x72, 0xb6, 0x94, 0xfd, 0x83, 0x58,
-	0x68, 0x2e, 0x74, 0xc8, 0x75, 0x1a, 0xda, 0xce, 0xec, 0x98, 0x8f, 0x37, 0xdb, 0xd4, 0x7f, 0x50,
-	0x20, 0x25, 0x26, 0x5d, 0x93, 0xa1, 0xc2, 0x92, 0xdf, 0x29, 0x28, 0x34, 0xc4, 0x26, 0x17, 0x85,
-	0xe7, 0xd1, 0x6a, 0x02, 0x06, 0xea, 0xa4, 0x41, 0x5a, 0xbb, 0x91, 0xbb, 0x37, 0x47, 0xb4, 0xb1,
-	0xda, 0x11, 0xa1, 0x2e, 0x07, 0xc6, 0x3b, 0xa4, 0x5b, 0x29, 0xe8, 0x5e, 0xa9, 0x31, 0x71, 0xde,
-	0x6a, 0x54, 0x4b, 0x41, 0xdf, 0x6b, 0x4c, 0x66, 0x92, 0xe5, 0x3d, 0x54, 0x4a, 0xa8, 0xfa, 0x5a,
-	0x83, 0xb4, 0xb6, 0xa3, 0x9a, 0xe5, 0xdd, 0xd9, 0xd3, 0x3b, 0xa6, 0x3b, 0x0a, 0x4d, 0xa9, 0x8a,
-	0x9e, 0x5b, 0xba, 0xee, 0x96, 0xd2, 0xf9, 0xd7, 0x15, 0x18, 0x38, 0x7b, 0x25, 0x94, 0xde, 0xe8,
-	0xf4, 0x16, 0x95, 0xcd, 0x63, 0xf4, 0x9e, 0xe9, 0x5e, 0xd7, 0x64, 0xbf, 0xf3, 0xb6, 0xd9, 0x5f,
-	0xed, 0xd9, 0xea, 0xbc, 0xfe, 0xc5, 0x7f, 0x1d, 0xf3, 0x86, 0xfe, 0xc6, 0xcb, 0xf7, 0xfb, 0x09,
-	0xb9, 0xbc, 0xfe, 0x98, 0x04, 0x64, 0x3c, 0x09, 0xc8, 0xd7, 0x24, 0x20, 0x6f, 0xd3, 0xa0, 0x32,
-	0x9e, 0x06, 0x95, 0xcf, 0x69, 0x50, 0x79, 0x3c, 0x95, 0x4f, 0x29, 0xeb, 0xa3, 0x82, 0x38, 0x83,
-	0xbc, 0x60, 0x09, 0xda, 0x70, 0x09, 0x6e, 0xc1, 0x62, 0xe8, 0x08, 0x9a, 0x91, 0x44, 0xdd, 0xdf,
-	0x74, 0x34, 0xce, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x0e, 0xd9, 0x14, 0xdd, 0x01, 0x00,
-	0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x4f, 0xd4, 0x5a,
+	0x14, 0xa6, 0x30, 0xc0, 0x9b, 0x33, 0x84, 0xf7, 0xb8, 0x8f, 0x84, 0xa1, 0x8f, 0x57, 0x61, 0x22,
+	0x4a, 0x50, 0x5b, 0x18, 0x89, 0x0b, 0xe2, 0x46, 0x01, 0x75, 0x43, 0x42, 0xaa, 0xc4, 0xc4, 0xcd,
+	0xe4, 0xd2, 0x1e, 0xdb, 0xc6, 0x69, 0x6f, 0xbd, 0xf7, 0x4e, 0xc3, 0xb8, 0x32, 0x6e, 0x75, 0xa1,
+	0x4b, 0x7f, 0x81, 0x5b, 0x7e, 0x86, 0x4b, 0x96, 0x2e, 0x0d, 0x2c, 0xf8, 0x1b, 0x66, 0x6e, 0x3b,
+	0x33, 0xe0, 0x74, 0x66, 0x80, 0xb8, 0x6a, 0x7b, 0xef, 0xf7, 0x9d, 0xef, 0xeb, 0x77, 0x7a, 0x7a,
+	0xe1, 0xff, 0x98, 0xd5, 0x29, 0x0f, 0x84, 0x85, 0x49, 0x68, 0x25, 0xeb, 0xb4, 0x1e, 0xfb, 0x74,
+	0xdd, 0x92, 0x87, 0x66, 0xcc, 0x99, 0x64, 0x64, 0x36, 0xdb, 0x36, 0x31, 0x09, 0xcd, 0xf6, 0xb6,
+	0x3e, 0xe7, 0x30, 0x11, 0x32, 0x61, 0x85, 0xc2, 0xb3, 0x92, 0xf5, 0xd6, 0x25, 0x85, 0xeb, 0x4b,
+	0xb9, 0xd5, 0x62, 0xca, 0x69, 0x28, 0x32, 0xc8, 0x72, 0x3e, 0x84, 0xa3, 0xc3, 0xc2, 0x38, 0xa8,
+	0x63, 0x0a, 0xab, 0xac, 0x81, 0xfe, 0x92, 0xd3, 0x38, 0x46, 0x77, 0x47, 0xfa, 0xc8, 0xb1, 0x11,
+	0xbe, 0xe0, 0x34, 0x12, 0xd4, 0x91, 0x01, 0x8b, 0x08, 0x81, 0x82, 0x4b, 0x25, 0x2d, 0x6b, 0x8b,
+	0xda, 0xca, 0x94, 0xad, 0xee, 0x2b, 0x4d, 0x58, 0xec, 0xcf, 0xb0, 0x51, 0x34, 0xea, 0x92, 0xcc,
+	0xc3, 0x5f, 0x1e, 0x15, 0xb5, 0x86, 0x40, 0x57, 0x71, 0x0b, 0xf6, 0xa4, 0x47, 0xc5, 0xbe, 0x40,
+	0xb7, 0xb5, 0x95, 0x84, 0x35, 0xe4, 0x9c, 0xf1, 0xf2, 0xe8, 0xa2, 0xb6, 0x52, 0xb4, 0x27, 0x93,
+	0x70, 0xa7, 0xf5, 0x48, 0x6e, 0x40, 0x89, 0xa3, 0x6c, 0xf0, 0xa8, 0xa6, 0x44, 0xc7, 0x94, 0x28,
+	0xa4, 0x4b, 0xdb, 0x2d, 0x69, 0x0f, 0x66, 0x77, 0x85, 0xb7, 0x1f, 0xbb, 0x54, 0xe2, 0x96, 0x4f,
+	0x83, 0x68, 0x8b, 0x45, 0xaf, 0x03, 0x8f, 0x2c, 0x40, 0x91, 0x36, 0xa4, 0xcf, 0x78, 0x20, 0x9b,
+	0x4a, 0xaf, 0x68, 0x77, 0x17, 0xc8, 0x12, 0x4c, 0x39, 0x2d, 0x70, 0xcd, 0x51, 0xe8, 0x4c, 0xb5,
+	0xe4, 0x74, 0x0b, 0x6c, 0x4e, 0x7f, 0x38, 0x3b, 0x5a, 0xed, 0x52, 0x2a, 0x06, 0x2c, 0xe4, 0x09,
+	0xd9, 0x28, 0x62, 0x16, 0x09, 0xac, 0x34, 0xe0, 0xef, 0xce, 0xfe, 0x9e, 0x4a, 0x7d, 0x88, 0x87,
+	0x0d, 0x98, 0x48, 0xbb, 0xa3, 0xd4, 0x4b, 0xd5, 0x05, 0x33, 0xaf, 0xe1, 0x66, 0x5a, 0xcb, 0xce,
+	0xb0, 0x3d, 0xb6, 0xe6, 0x61, 0xee, 0x37, 0xd9, 0x8e, 0xa3, 0x6f, 0x9a, 0xb2, 0xfc, 0xc8, 0x91,
+	0x41, 0x42, 0x25, 0x6e, 0x37, 0x23, 0x1a, 0x06, 0xce, 0x5e, 0xa7, 0xdd, 0x43, 0xfc, 0x11, 0x28,
+	0x44, 0x34, 0xc4, 0x2c, 0x1b, 0x75, 0x4f, 0xca, 0x30, 0x49, 0x5d, 0x97, 0xa3, 0x10, 0xaa, 0x15,
+	0x45, 0xbb, 0xfd, 0x48, 0xee, 0xc0, 0x0c, 0x4d, 0x85, 0x02, 0x16, 0xd5, 0x7c, 0x0c, 0x3c, 0x5f,
+	0x96, 0x0b, 0xaa, 0xcf, 0xff, 0x74, 0x37, 0x9e, 0xa9, 0xf5, 0x9e, 0x97, 0x60, 0x70, 0x73, 0x90,
+	0xd1, 0xf6, 0x1b, 0x91, 0xa7, 0x00, 0xdd, 0xaf, 0x55, 0x39, 0x2e, 0x55, 0x6f, 0xe7, 0xc7, 0xd6,
+	0x5b, 0xe4, 0x1c, 0xb5, 0xf2, 0x55, 0x03, 0x63, 0x57, 0x78, 0xdb, 0x48, 0xaf, 0x19, 0xce, 0xb9,
+	0x20, 0x46, 0x2f, 0x06, 0x61, 0xc1, 0xbf, 0x2e, 0xf6, 0x46, 0x31, 0xa6, 0xa2, 0x20, 0x2e, 0x0e,
+	0x0d, 0xe3, 0x2d, 0xdc, 0x1a, 0x6c, 0xed, 0x8f, 0xc7, 0x51, 0xfd, 0x34, 0x0e, 0xb0, 0x2b, 0xbc,
+	0xe7, 0xc8, 0x93, 0xc0, 0x41, 0xf2, 0x0e, 0xa6, 0x77, 0xa4, 0x7f, 0x7e, 0xe8, 0xd7, 0xf2, 0xab,
+	0xf6, 0x1f, 0x7a, 0xfd, 0xc1, 0x55, 0x19, 0xd9, 0x6f, 0x42, 0xc0, 0x4c, 0xef, 0x30, 0xaf, 0xe6,
+	0x17, 0xcb, 0x9b, 0x47, 0xbd, 0x7a, 0x79, 0x6c, 0x27, 0x48, 0x17, 0xa6, 0x2e, 0x0c, 0xee, 0xf2,
+	0x90, 0x1a, 0x29, 0x4c, 0xbf, 0x77, 0x29, 0x58, 0x47, 0xe5, 0xa3, 0x06, 0xf3, 0xfd, 0x87, 0xb1,
+	0xbf, 0xef, 0xbe, 0x1c, 0x7d, 0xf3, 0xea, 0x9c, 0x8e, 0x9b, 0x2f, 0x1a, 0xfc, 0x37, 0xe8, 0xfb,
+	0xdf, 0xe8, 0x5b, 0x7b, 0x00, 0x4b, 0x7f, 0x78, 0x1d, 0x56, 0xdb, 0x93, 0x3e, 0xfe, 0xfe, 0xec,
+	0x68, 0x55, 0x7b, 0xfc, 0xe4, 0xfb, 0x89, 0xa1, 0x1d, 0x9f, 0x18, 0xda, 0xcf, 0x13, 0x43, 0xfb,
+	0x7c, 0x6a, 0x8c, 0x1c, 0x9f, 0x1a, 0x23, 0x3f, 0x4e, 0x8d, 0x91, 0x57, 0x77, 0xe3, 0x37, 0x9e,
+	0x79, 0x80, 0x9c, 0xaa, 0x3f, 0xb5, 0xe9, 0x62, 0x62, 0xb5, 0xcf, 0xb4, 0xec, 0x5c, 0x3c, 0x54,
+	0x87, 0x9b, 0x6c, 0xc6, 0x28, 0x0e, 0x26, 0xd4, 0x79, 0x76, 0xff, 0xd7, 0x00, 0x15, 0x38, 0xf0,
+	0x7a, 0x69, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -184,6 +635,18 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgServiceClient interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
+	// UpdateChainConfig defines a governance operation for updating the Ethereum chain config. The
+	// authority is defined in the keeper.
+	UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error)
+	// UpdateParams defines a governance operation for updating the x/evm module parameters. The
+	// authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ActivateDynamicPrecompile defines a governance operation for activating a dynamic precompile
+	// at an address. The authority is defined in the keeper.
+	ActivateDynamicPrecompile(ctx context.Context, in *MsgActivateDynamicPrecompile, opts ...grpc.CallOption) (*MsgActivateDynamicPrecompileResponse, error)
+	// DeactivateDynamicPrecompile defines a governance operation for deactivating the dynamic
+	// precompile at an address. The authority is defined in the keeper.
+	DeactivateDynamicPrecompile(ctx context.Context, in *MsgDeactivateDynamicPrecompile, opts ...grpc.CallOption) (*MsgDeactivateDynamicPrecompileResponse, error)
 }
 
 type msgServiceClient struct {
@@ -203,22 +666,82 @@ func (c *msgServiceClient) EthTransaction(ctx context.Context, in *WrappedEthere
 	return out, nil
 }
 
-// MsgServiceServer is the server API for MsgService service.
-type MsgServiceServer interface {
-	// EthTransaction defines a method submitting Ethereum transactions.
-	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
+func (c *msgServiceClient) UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error) {
+	out := new(MsgUpdateChainConfigResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/UpdateChainConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServiceServer struct {
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedMsgServiceServer) EthTransaction(ctx context.Context, req *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EthTransaction not implemented")
+func (c *msgServiceClient) ActivateDynamicPrecompile(ctx context.Context, in *MsgActivateDynamicPrecompile, opts ...grpc.CallOption) (*MsgActivateDynamicPrecompileResponse, error) {
+	out := new(MsgActivateDynamicPrecompileResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/ActivateDynamicPrecompile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
-	s.RegisterService(&_MsgService_serviceDesc, srv)
+func (c *msgServiceClient) DeactivateDynamicPrecompile(ctx context.Context, in *MsgDeactivateDynamicPrecompile, opts ...grpc.CallOption) (*MsgDeactivateDynamicPrecompileResponse, error) {
+	out := new(MsgDeactivateDynamicPrecompileResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/DeactivateDynamicPrecompile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServiceServer is the server API for MsgService service.
+type MsgServiceServer interface {
+	// EthTransaction defines a method submitting Ethereum transactions.
+	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
+	// UpdateChainConfig defines a governance operation for updating the Ethereum chain config. The
+	// authority is defined in the keeper.
+	UpdateChainConfig(context.Context, *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error)
+	// UpdateParams defines a governance operation for updating the x/evm module parameters. The
+	// authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ActivateDynamicPrecompile defines a governance operation for activating a dynamic precompile
+	// at an address. The authority is defined in the keeper.
+	ActivateDynamicPrecompile(context.Context, *MsgActivateDynamicPrecompile) (*MsgActivateDynamicPrecompileResponse, error)
+	// DeactivateDynamicPrecompile defines a governance operation for deactivating the dynamic
+	// precompile at an address. The authority is defined in the keeper.
+	DeactivateDynamicPrecompile(context.Context, *MsgDeactivateDynamicPrecompile) (*MsgDeactivateDynamicPrecompileResponse, error)
+}
+
+// UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServiceServer struct {
+}
+
+func (*UnimplementedMsgServiceServer) EthTransaction(ctx context.Context, req *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthTransaction not implemented")
+}
+func (*UnimplementedMsgServiceServer) UpdateChainConfig(ctx context.Context, req *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainConfig not implemented")
+}
+func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServiceServer) ActivateDynamicPrecompile(ctx context.Context, req *MsgActivateDynamicPrecompile) (*MsgActivateDynamicPrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateDynamicPrecompile not implemented")
+}
+func (*UnimplementedMsgServiceServer) DeactivateDynamicPrecompile(ctx context.Context, req *MsgDeactivateDynamicPrecompile) (*MsgDeactivateDynamicPrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDynamicPrecompile not implemented")
+}
+
+func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
+	s.RegisterService(&_MsgService_serviceDesc, srv)
 }
 
 func _MsgService_EthTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -239,6 +762,78 @@ func _MsgService_EthTransaction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_UpdateChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateChainConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/UpdateChainConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateChainConfig(ctx, req.(*MsgUpdateChainConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ActivateDynamicPrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateDynamicPrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ActivateDynamicPrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/ActivateDynamicPrecompile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ActivateDynamicPrecompile(ctx, req.(*MsgActivateDynamicPrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DeactivateDynamicPrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateDynamicPrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DeactivateDynamicPrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/DeactivateDynamicPrecompile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DeactivateDynamicPrecompile(ctx, req.(*MsgDeactivateDynamicPrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "polaris.evm.v1alpha1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -247,6 +842,22 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EthTransaction",
 			Handler:    _MsgService_EthTransaction_Handler,
 		},
+		{
+			MethodName: "UpdateChainConfig",
+			Handler:    _MsgService_UpdateChainConfig_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ActivateDynamicPrecompile",
+			Handler:    _MsgService_ActivateDynamicPrecompile_Handler,
+		},
+		{
+			MethodName: "DeactivateDynamicPrecompile",
+			Handler:    _MsgService_DeactivateDynamicPrecompile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "polaris/evm/v1alpha1/tx.proto",
@@ -324,57 +935,1181 @@ func (m *WrappedEthereumTransactionResult) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateChainConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WrappedEthereumTransaction) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgUpdateChainConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainConfig) > 0 {
+		i -= len(m.ChainConfig)
+		copy(dAtA[i:], m.ChainConfig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainConfig)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateDynamicPrecompile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateDynamicPrecompile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateDynamicPrecompile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateDynamicPrecompileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateDynamicPrecompileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateDynamicPrecompileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Precompile != nil {
+		{
+			size, err := m.Precompile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateDynamicPrecompile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateDynamicPrecompile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateDynamicPrecompile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeactivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeactivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateDynamicPrecompileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateDynamicPrecompileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateDynamicPrecompileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Precompile != nil {
+		{
+			size, err := m.Precompile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WrappedEthereumTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *WrappedEthereumTransactionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateChainConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainConfig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateChainConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateDynamicPrecompile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgActivateDynamicPrecompileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Precompile != nil {
+		l = m.Precompile.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeactivateDynamicPrecompile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeactivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeactivationHeight))
+	}
+	return n
+}
+
+func (m *MsgDeactivateDynamicPrecompileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Precompile != nil {
+		l = m.Precompile.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedEthereumTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedEthereumTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedEthereumTransactionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedEthereumTransactionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateDynamicPrecompile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateDynamicPrecompile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateDynamicPrecompile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *WrappedEthereumTransactionResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GasUsed != 0 {
-		n += 1 + sovTx(uint64(m.GasUsed))
-	}
-	l = len(m.VmError)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ReturnData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
+func (m *MsgActivateDynamicPrecompileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -397,17 +2132,17 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedEthereumTransaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgActivateDynamicPrecompileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedEthereumTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgActivateDynamicPrecompileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompile", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -417,24 +2152,26 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.Precompile == nil {
+				m.Precompile = &DynamicPrecompile{}
+			}
+			if err := m.Precompile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -458,7 +2195,7 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
+func (m *MsgDeactivateDynamicPrecompile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -481,17 +2218,17 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedEthereumTransactionResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeactivateDynamicPrecompile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedEthereumTransactionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeactivateDynamicPrecompile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.GasUsed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -501,14 +2238,27 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -536,13 +2286,82 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivationHeight", wireType)
+			}
+			m.DeactivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeactivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateDynamicPrecompileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateDynamicPrecompileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateDynamicPrecompileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompile", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -552,24 +2371,26 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
-			if m.ReturnData == nil {
-				m.ReturnData = []byte{}
+			if m.Precompile == nil {
+				m.Precompile = &DynamicPrecompile{}
+			}
+			if err := m.Precompile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -21,6 +21,7 @@
 package testapp
 
 import (
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
@@ -109,6 +110,9 @@ type SimApp struct {
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// historicalDB is the node-local database of the historical evm data, closed in `Close`.
+	historicalDB dbm.DB
 }
 
 //nolint:gochecknoinits // from sdk.
@@ -228,17 +232,23 @@ func NewPolarisApp(
 
 	// TODO: MOVE EVM SETUP
 	// ----- BEGIN EVM SETUP ----------------------------------------------
-	// TODO: reenable offchain
-	// offchainKey := storetypes.NewKVStoreKey("offchain-evm")
-	// app.MountStore(offchainKey, storetypes.StoreTypeDB)
 	homePath, ok := appOpts.Get(flags.FlagHome).(string)
 	if !ok || homePath == "" {
 		homePath = DefaultNodeHome
 	}
+	// open the node-local database that stores the historical evm blocks, receipts, and txs.
+	historicalDB, err := dbm.NewDB(
+		"historical", server.GetAppDBBackend(appOpts), homePath+"/data/polaris",
+	)
+	if err != nil {
+		panic(err)
+	}
+	app.historicalDB = historicalDB
 	// setup evm keeper and all of its plugins.
 	app.EVMKeeper.Setup(
-		nil,
+		historicalDB,
 		app.CreateQueryContext,
+		app.Query,
 		// TODO: clean this up.
 		homePath+"/config/polaris.toml",
 		homePath+"/data/polaris",
@@ -253,6 +263,7 @@ func NewPolarisApp(
 	}
 	ch, _ := evmante.NewAnteHandler(
 		opt,
+		app.EVMKeeper,
 	)
 	app.SetAnteHandler(
 		ch,
@@ -295,6 +306,12 @@ func NewPolarisApp(
 	return app
 }
 
+// Close stops the background services of the EVM and closes the app, along with the historical
+// database of the EVM once nothing reads from it anymore.
+func (app *SimApp) Close() error {
+	return errors.Join(app.EVMKeeper.Close(), app.App.Close(), app.historicalDB.Close())
+}
+
 // Name returns the name of the App.
 func (app *SimApp) Name() string { return app.BaseApp.Name() }
 
@@ -36,12 +36,15 @@ type (
 	Arguments          = abi.Arguments
 	Event              = abi.Event
 	Method             = abi.Method
+	Type               = abi.Type
 )
 
 var (
-	MakeTopics = abi.MakeTopics
-	NewEvent   = abi.NewEvent
-	NewType    = abi.NewType
+	ConvertType = abi.ConvertType
+	JSON        = abi.JSON
+	MakeTopics  = abi.MakeTopics
+	NewEvent    = abi.NewEvent
+	NewType     = abi.NewType
 )
 
 // ToMixedCase converts a under_score formatted string to mixedCase format (camelCase with the
@@ -25,8 +25,15 @@ import (
 )
 
 type (
-	Bytes = hexutil.Bytes
-	Uint  = hexutil.Uint
+	Big    = hexutil.Big
+	Bytes  = hexutil.Bytes
+	Uint   = hexutil.Uint
+	Uint64 = hexutil.Uint64
 )
 
-var MustDecode = hexutil.MustDecode
+var (
+	Decode     = hexutil.Decode
+	Encode     = hexutil.Encode
+	EncodeBig  = hexutil.EncodeBig
+	MustDecode = hexutil.MustDecode
+)
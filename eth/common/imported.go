@@ -34,6 +34,7 @@ var (
 	HashLength     = common.HashLength
 	BytesToAddress = common.BytesToAddress
 	Big0           = common.Big0
+	Big1           = common.Big1
 	BigToHash      = common.BigToHash
 	BytesToHash    = common.BytesToHash
 	Bytes2Hex      = common.Bytes2Hex
@@ -41,5 +42,6 @@ var (
 	HexToAddress   = common.HexToAddress
 	Hex2Bytes      = common.Hex2Bytes
 	HexToHash      = common.HexToHash
+	IsHexAddress   = common.IsHexAddress
 	LeftPadBytes   = common.LeftPadBytes
 )
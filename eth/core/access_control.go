@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// checkAccessControl returns an error if the sender of the given transaction is not allowed to
+// send it by the access controller. If the sender is not allowed to create contracts, it returns a
+// guard of the state db to execute the transaction with, which fails its contract creations.
+func (sp *StateProcessor) checkAccessControl(tx *types.Transaction) (*createGuard, error) {
+	from, err := types.Sender(sp.signer, tx)
+	if err != nil {
+		return nil, errorslib.Wrapf(err, "could not recover sender [%s]", tx.Hash().Hex())
+	}
+
+	switch {
+	case !sp.ac.CanSendTransaction(from):
+		return nil, errorslib.Wrapf(ErrSendNotAllowed, "%s [%s]", from.Hex(), tx.Hash().Hex())
+	case sp.ac.CanCreateContract(from):
+		return nil, nil //nolint:nilnil // no guard is needed.
+	case tx.To() == nil:
+		return nil, errorslib.Wrapf(ErrCreateNotAllowed, "%s [%s]", from.Hex(), tx.Hash().Hex())
+	default:
+		return &createGuard{PolarisStateDB: sp.statedb, from: from}, nil
+	}
+}
+
+// createGuard is a state db that fails every contract creation, with CREATE or CREATE2, of the
+// EVM that executes a transaction with it. The EVM increments the nonce of the creator before it
+// creates a contract and checks the error of the state db right after, so the guard refuses every
+// nonce update but the one of the sender of the transaction by the state transition, and reports
+// it as the error of the state db. The EVM then fails the creation frame, which returns no address
+// to its caller as for any failed creation, and the transaction is still included.
+type createGuard struct {
+	vm.PolarisStateDB
+
+	// from is the sender of the transaction, whose nonce is incremented once by the state
+	// transition before the EVM executes the transaction.
+	from   common.Address
+	bumped bool
+	// err is the error of the refused nonce update, reported once by Error.
+	err error
+}
+
+// SetNonce implements vm.GethStateDB. It refuses the nonce updates of contract creations.
+func (g *createGuard) SetNonce(addr common.Address, nonce uint64) {
+	if !g.bumped && addr == g.from {
+		g.bumped = true
+		g.PolarisStateDB.SetNonce(addr, nonce)
+		return
+	}
+	g.err = errorslib.Wrapf(ErrCreateNotAllowed, "%s", g.from.Hex())
+}
+
+// Error implements vm.GethStateDB. It returns the error of a refused nonce update once, then the
+// error of the wrapped state db.
+func (g *createGuard) Error() error {
+	if err := g.err; err != nil {
+		g.err = nil
+		return err
+	}
+	return g.PolarisStateDB.Error()
+}
@@ -24,6 +24,8 @@ import (
 	"sync/atomic"
 
 	lru "github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/event"
 
 	"pkg.berachain.dev/polaris/eth/common"
@@ -65,6 +67,8 @@ type blockchain struct {
 	statedb vm.PolarisStateDB
 	// vmConfig is the configuration used to create the EVM.
 	vmConfig *vm.Config
+	// engine is the (fake) consensus engine exposed via `ChainContext`.
+	engine consensus.Engine
 
 	// currentBlock is the current/pending block.
 	currentBlock atomic.Pointer[types.Block]
@@ -89,14 +93,13 @@ type blockchain struct {
 	txLookupCache *lru.Cache[common.Hash, *types.TxLookupEntry]
 
 	// subscription event feeds
-	scope           event.SubscriptionScope
-	chainFeed       event.Feed
-	chainHeadFeed   event.Feed
-	logsFeed        event.Feed
-	pendingLogsFeed event.Feed
-	rmLogsFeed      event.Feed // currently never used
-	chainSideFeed   event.Feed // currently never used
-	logger          log.Logger
+	scope         event.SubscriptionScope
+	chainFeed     event.Feed
+	chainHeadFeed event.Feed
+	logsFeed      event.Feed
+	rmLogsFeed    event.Feed // currently never used
+	chainSideFeed event.Feed // currently never used
+	logger        log.Logger
 }
 
 // =========================================================================
@@ -113,6 +116,7 @@ func NewChain(host PolarisHostChain) *blockchain { //nolint:revive // only used
 		sp:             host.GetStatePlugin(),
 		tp:             host.GetTxPoolPlugin(),
 		vmConfig:       &vm.Config{},
+		engine:         ethash.NewFaker(),
 		receiptsCache:  lru.NewCache[common.Hash, types.Receipts](defaultCacheSize),
 		blockNumCache:  lru.NewCache[uint64, *types.Block](defaultCacheSize),
 		blockHashCache: lru.NewCache[common.Hash, *types.Block](defaultCacheSize),
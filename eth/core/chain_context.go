@@ -36,7 +36,9 @@ func (bc *blockchain) GetHeader(hash common.Hash, number uint64) *types.Header {
 	return header
 }
 
-// Engine returns the consensus engine. For our use case, this never gets called.
+// Engine returns the consensus engine. Polaris leaves consensus up to the host chain, but callers
+// such as the tracers use the engine to resolve the author of a block, so we return a faker that
+// reads the coinbase from the header.
 func (bc *blockchain) Engine() consensus.Engine {
-	return nil
+	return bc.engine
 }
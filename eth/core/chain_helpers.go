@@ -25,7 +25,8 @@ import (
 	"pkg.berachain.dev/polaris/eth/core/types"
 )
 
-// deriveReceipts derives the receipts from the block.
+// deriveReceipts derives the receipts from the block. The host receipt of the block, if any,
+// follows the receipts of the transactions.
 func (bc *blockchain) deriveReceipts(receipts types.Receipts, blockHash common.Hash) (types.Receipts, error) {
 	// get the block to derive the receipts
 	block := bc.GetBlockByHash(blockHash)
@@ -34,10 +35,17 @@ func (bc *blockchain) deriveReceipts(receipts types.Receipts, blockHash common.H
 	}
 
 	// Derive receipts from block.
-	if err := receipts.DeriveFields(
+	txReceipts := receipts
+	if numTxs := len(block.Transactions()); len(receipts) == numTxs+1 {
+		txReceipts = receipts[:numTxs]
+	}
+	if err := txReceipts.DeriveFields(
 		bc.Config(), block.Hash(), block.Number().Uint64(), block.Time(), block.BaseFee(), block.Transactions(),
 	); err != nil {
 		return nil, err
 	}
+	if len(receipts) > len(txReceipts) {
+		types.DeriveHostReceiptFields(receipts[len(txReceipts)], block.Hash(), block.Number(), txReceipts)
+	}
 	return receipts, nil
 }
@@ -49,9 +49,8 @@ type ChainBlockReader interface {
 	GetBlockByNumber(uint64) *types.Block
 	GetTransactionLookup(common.Hash) *types.TxLookupEntry
 	GetTd(common.Hash, uint64) *big.Int
-
-	// THIS SHOULD BE MOVED TO A "MINER" TYPE THING
-	PendingBlockAndReceipts() (*types.Block, types.Receipts)
+	EarliestBlockNumber() uint64
+	IsHistoryPruned(common.Hash) bool
 }
 
 // ChainTxPoolReader defines methods that are used to read information about the state
@@ -115,44 +114,6 @@ func (bc *blockchain) CurrentSafeBlock() *types.Header {
 	return bc.CurrentFinalBlock()
 }
 
-// PendingBlockAndReceipts returns the pending block and receipts of the blockchain.
-// TODO: move to the miner. Currently returns the "current" finalized block and receipts.
-func (bc *blockchain) PendingBlockAndReceipts() (*types.Block, types.Receipts) {
-	var err error
-
-	// Get current block.
-	header := bc.CurrentHeader()
-	if header == nil {
-		bc.logger.Error("current header is nil")
-		return nil, nil
-	}
-
-	// Get receipts from cache.
-	receipts, ok := utils.GetAs[types.Receipts](bc.currentReceipts.Load())
-	if receipts == nil || !ok {
-		bc.logger.Error("current receipts are nil")
-		return nil, nil
-	}
-
-	// Derive receipts from block.
-	receipts, err = bc.deriveReceipts(receipts, header.Hash())
-	if err != nil {
-		bc.logger.Error("failed to derive receipts", "err", err)
-		return nil, nil
-	}
-
-	// Get block
-	block := bc.GetBlock(header.Hash(), header.Number.Uint64())
-	if block == nil {
-		bc.logger.Error("failed to get block", "hash", header.Hash(), "number", header.Number.Uint64())
-		return nil, nil
-	}
-
-	// Add to cache.
-	bc.receiptsCache.Add(block.Hash(), receipts)
-	return block, receipts
-}
-
 // GetBlock returns a block by its hash or number.
 func (bc *blockchain) GetBlock(hash common.Hash, number uint64) *types.Block {
 	if block := bc.GetBlockByHash(hash); block != nil {
@@ -315,6 +276,24 @@ func (bc *blockchain) GetHeaderByHash(hash common.Hash) *types.Header {
 	return header
 }
 
+// EarliestBlockNumber returns the number of the earliest block for which historical data is
+// available, which is always 0 if the historical plugin does not prune.
+func (bc *blockchain) EarliestBlockNumber() uint64 {
+	if pruner, ok := bc.hp.(HistoricalPruner); ok {
+		return pruner.EarliestBlockNumber()
+	}
+	return 0
+}
+
+// IsHistoryPruned returns whether the block or transaction with the given hash has been pruned
+// from the historical plugin.
+func (bc *blockchain) IsHistoryPruned(hash common.Hash) bool {
+	if pruner, ok := bc.hp.(HistoricalPruner); ok {
+		return pruner.IsPruned(hash)
+	}
+	return false
+}
+
 // GetTd retrieves a block's total difficulty in the canonical chain from the
 // database by hash and number, caching it if found.
 func (bc *blockchain) GetTd(hash common.Hash, number uint64) *big.Int {
@@ -22,6 +22,8 @@ package core
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
 
 	"pkg.berachain.dev/polaris/eth/core/state"
@@ -33,9 +35,11 @@ import (
 // resources to use in execution such as StateDBss and EVMss.
 type ChainResources interface {
 	StateAtBlockNumber(uint64) (vm.GethStateDB, error)
+	StateAtTransaction(context.Context, *types.Block, int) (*Message, vm.BlockContext, vm.GethStateDB, error)
 	GetVMConfig() *vm.Config
 	GetEVM(context.Context, vm.TxContext, vm.PolarisStateDB, *types.Header, *vm.Config) *vm.GethEVM
 	NewEVMBlockContext(header *types.Header) *vm.BlockContext
+	NewSimulationProcessor(vm.PolarisStateDB, uint64) *StateProcessor
 }
 
 // StateAtBlockNumber returns a statedb configured to read what the state of the blockchain is/was
@@ -48,6 +52,62 @@ func (bc *blockchain) StateAtBlockNumber(number uint64) (vm.GethStateDB, error)
 	return state.NewStateDB(sp), nil
 }
 
+// StateAtTransaction returns the execution environment of the transaction at `txIndex` in the
+// given block. The returned statedb is built by replaying all of the preceding transactions in the
+// block on top of the state of the parent block, with a simulation processor of the chain, so that
+// they run with the same access control and precompiles as when the block was processed.
+func (bc *blockchain) StateAtTransaction(
+	ctx context.Context, block *types.Block, txIndex int,
+) (*Message, vm.BlockContext, vm.GethStateDB, error) {
+	// Short circuit if it's the genesis block.
+	if block.NumberU64() == 0 {
+		return nil, vm.BlockContext{}, nil, errors.New("no transaction in genesis")
+	}
+	txs := block.Transactions()
+	if txIndex < 0 || txIndex >= len(txs) {
+		return nil, vm.BlockContext{}, nil, fmt.Errorf(
+			"transaction index %d out of range for block %s", txIndex, block.Hash().Hex(),
+		)
+	}
+
+	// Build the statedb from the state of the parent block.
+	sp, err := bc.sp.StateAtBlockNumber(block.NumberU64() - 1)
+	if err != nil {
+		return nil, vm.BlockContext{}, nil, err
+	}
+	statedb := state.NewStateDB(sp)
+
+	var (
+		header       = block.Header()
+		blockContext = bc.NewEVMBlockContext(header)
+		processor    = bc.NewSimulationProcessor(statedb, header.GasLimit)
+	)
+
+	// Replay the transactions in the block up until the requested one. The gas used by the block
+	// is accumulated again by the processor.
+	header.GasUsed = 0
+	processor.Prepare(
+		vm.NewGethEVMWithPrecompiles(
+			*blockContext, vm.TxContext{}, statedb, bc.Config(), *bc.vmConfig,
+			processor.PrecompileManager(),
+		),
+		header,
+	)
+	for _, tx := range txs[:txIndex] {
+		if _, err = processor.ProcessTransaction(ctx, tx); err != nil {
+			return nil, vm.BlockContext{}, nil, err
+		}
+	}
+
+	msg, err := TransactionToMessage(
+		txs[txIndex], types.MakeSigner(bc.Config(), header.Number, header.Time), header.BaseFee,
+	)
+	if err != nil {
+		return nil, vm.BlockContext{}, nil, err
+	}
+	return msg, *blockContext, statedb, nil
+}
+
 // GetEVM returns an EVM ready to be used for executing transactions. It is used by both the
 // StateProcessor to acquire a new EVM at the start of every block. As well as by the backend to
 // acquire an EVM for running gas estimations, eth_call etc.
@@ -70,6 +130,14 @@ func (bc *blockchain) NewEVMBlockContext(header *types.Header) *vm.BlockContext
 	return &blockContext
 }
 
+// NewSimulationProcessor returns a state processor that simulates blocks with the given gas limit
+// on top of the given state, with the configuration, access control and precompiles of the chain.
+func (bc *blockchain) NewSimulationProcessor(
+	state vm.PolarisStateDB, gasLimit uint64,
+) *StateProcessor {
+	return bc.processor.NewSimulationProcessor(state, gasLimit)
+}
+
 // GetVMConfig returns the vm.Config for the current chain.
 func (bc *blockchain) GetVMConfig() *vm.Config {
 	return bc.vmConfig
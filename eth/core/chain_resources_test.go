@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/trie"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/mock"
+	"pkg.berachain.dev/polaris/eth/core/state"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chain Resources", func() {
+	var (
+		from      = crypto.PubkeyToAddress(key.PublicKey)
+		recipient = common.BytesToAddress([]byte{0x11})
+		reverter  = common.BytesToAddress([]byte{0x22})
+		coinbase  = common.BytesToAddress([]byte{0x33})
+		parent    *memStatePlugin
+		chain     core.ChainResources
+		block     *types.Block
+	)
+
+	BeforeEach(func() {
+		host, _, _, _, _, pp, _, _ := mock.NewMockHostAndPlugins()
+		pp.HasFunc = func(common.Address) bool { return false }
+
+		// The parent state funds the sender and holds a contract that always reverts.
+		parent = newMemStatePlugin()
+		parent.CreateAccount(from)
+		parent.SetBalance(from, big.NewInt(1e18))
+		parent.CreateAccount(reverter)
+		parent.SetCode(reverter, common.Hex2Bytes("60006000fd"))
+		host.GetStatePluginFunc = func() core.StatePlugin { return parent }
+		chain = core.NewChain(host)
+
+		// The block transfers to the recipient, calls the reverting contract with value and then
+		// transfers to the recipient again.
+		txs := make(types.Transactions, 0, 3)
+		for i, data := range []*types.LegacyTx{
+			{To: &recipient, Value: big.NewInt(100), Gas: 21000},
+			{To: &reverter, Value: big.NewInt(50), Gas: 100000},
+			{To: &recipient, Value: big.NewInt(200), Gas: 21000},
+		} {
+			data.Nonce = uint64(i)
+			data.GasPrice = big.NewInt(2)
+			txs = append(txs, types.MustSignNewTx(key, signer, data))
+		}
+		block = types.NewBlock(&types.Header{
+			Number:     big.NewInt(1),
+			BaseFee:    big.NewInt(1),
+			GasLimit:   uint64(blockGasLimit),
+			Coinbase:   coinbase,
+			Difficulty: new(big.Int),
+		}, txs, nil, nil, trie.NewStackTrie(nil))
+	})
+
+	It("should return the state of the given block", func() {
+		statedb, err := chain.StateAtBlockNumber(0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statedb.GetBalance(from)).To(Equal(big.NewInt(1e18)))
+		Expect(statedb.GetCode(reverter)).To(Equal(common.Hex2Bytes("60006000fd")))
+	})
+
+	It("should return the parent state for the first transaction", func() {
+		msg, _, statedb, err := chain.StateAtTransaction(context.Background(), block, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg.From).To(Equal(from))
+		Expect(msg.Nonce).To(BeZero())
+		Expect(statedb.GetNonce(from)).To(BeZero())
+		Expect(statedb.GetBalance(recipient)).To(Equal(new(big.Int)))
+	})
+
+	It("should replay the preceding transactions, including the reverted ones", func() {
+		msg, blockContext, statedb, err := chain.StateAtTransaction(
+			context.Background(), block, 2,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg.Nonce).To(Equal(uint64(2)))
+		Expect(msg.Value).To(Equal(big.NewInt(200)))
+		Expect(blockContext.BlockNumber).To(Equal(big.NewInt(1)))
+		Expect(blockContext.Coinbase).To(Equal(coinbase))
+
+		// The reverted call still bumps the nonce and pays for its gas, but not its value.
+		Expect(statedb.GetNonce(from)).To(Equal(uint64(2)))
+		Expect(statedb.GetBalance(recipient)).To(Equal(big.NewInt(100)))
+		Expect(statedb.GetBalance(reverter)).To(Equal(new(big.Int)))
+		// The tip of 1 per gas goes to the coinbase and the base fee of 1 per gas is burned.
+		gasUsed := statedb.GetBalance(coinbase)
+		Expect(gasUsed.Uint64()).To(BeNumerically(">", 2*21000))
+		Expect(statedb.GetBalance(from)).To(Equal(new(big.Int).Sub(
+			big.NewInt(1e18-100), new(big.Int).Mul(gasUsed, big.NewInt(2)),
+		)))
+
+		// The state of the parent block is left untouched.
+		Expect(parent.GetNonce(from)).To(BeZero())
+	})
+
+	It("should fail on a transaction index out of range", func() {
+		_, _, _, err := chain.StateAtTransaction(context.Background(), block, 3)
+		Expect(err).To(MatchError(ContainSubstring("out of range")))
+		_, _, _, err = chain.StateAtTransaction(context.Background(), block, -1)
+		Expect(err).To(MatchError(ContainSubstring("out of range")))
+	})
+
+	It("should fail on the genesis block", func() {
+		_, _, _, err := chain.StateAtTransaction(
+			context.Background(), types.NewBlockWithHeader(&types.Header{Number: new(big.Int)}), 0,
+		)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+// memAccount is an account of the `memStatePlugin`.
+type memAccount struct {
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+	storage map[common.Hash]common.Hash
+}
+
+// memStatePlugin is an in-memory `core.StatePlugin`, which snapshots by copying its accounts.
+type memStatePlugin struct {
+	accounts  map[common.Address]*memAccount
+	snapshots []map[common.Address]*memAccount
+}
+
+func newMemStatePlugin() *memStatePlugin {
+	return &memStatePlugin{accounts: make(map[common.Address]*memAccount)}
+}
+
+func (p *memStatePlugin) clone() *memStatePlugin {
+	return &memStatePlugin{accounts: copyAccounts(p.accounts)}
+}
+
+func copyAccounts(accounts map[common.Address]*memAccount) map[common.Address]*memAccount {
+	cpy := make(map[common.Address]*memAccount, len(accounts))
+	for addr, acc := range accounts {
+		storage := make(map[common.Hash]common.Hash, len(acc.storage))
+		for k, v := range acc.storage {
+			storage[k] = v
+		}
+		cpy[addr] = &memAccount{
+			balance: new(big.Int).Set(acc.balance), nonce: acc.nonce, code: acc.code,
+			storage: storage,
+		}
+	}
+	return cpy
+}
+
+// account returns the account at the given address, creating it if it does not exist.
+func (p *memStatePlugin) account(addr common.Address) *memAccount {
+	if _, ok := p.accounts[addr]; !ok {
+		p.CreateAccount(addr)
+	}
+	return p.accounts[addr]
+}
+
+func (p *memStatePlugin) StateAtBlockNumber(uint64) (core.StatePlugin, error) {
+	return p.clone(), nil
+}
+
+func (p *memStatePlugin) RegistryKey() string { return "memstate" }
+
+func (p *memStatePlugin) Snapshot() int {
+	p.snapshots = append(p.snapshots, copyAccounts(p.accounts))
+	return len(p.snapshots) - 1
+}
+
+func (p *memStatePlugin) RevertToSnapshot(id int) {
+	p.accounts = p.snapshots[id]
+	p.snapshots = p.snapshots[:id]
+}
+
+func (p *memStatePlugin) Finalize() { p.snapshots = nil }
+
+func (p *memStatePlugin) Prepare(context.Context) {}
+
+func (p *memStatePlugin) Reset(context.Context) {}
+
+func (p *memStatePlugin) Clone() state.Plugin { return p.clone() }
+
+func (p *memStatePlugin) GetContext() context.Context { return context.Background() }
+
+func (p *memStatePlugin) Error() error { return nil }
+
+func (p *memStatePlugin) CreateAccount(addr common.Address) {
+	p.accounts[addr] = &memAccount{
+		balance: new(big.Int), storage: make(map[common.Hash]common.Hash),
+	}
+}
+
+func (p *memStatePlugin) Exist(addr common.Address) bool {
+	_, ok := p.accounts[addr]
+	return ok
+}
+
+func (p *memStatePlugin) Empty(addr common.Address) bool {
+	acc, ok := p.accounts[addr]
+	return !ok || (acc.balance.Sign() == 0 && acc.nonce == 0 && len(acc.code) == 0)
+}
+
+func (p *memStatePlugin) DeleteAccounts(addrs []common.Address) {
+	for _, addr := range addrs {
+		delete(p.accounts, addr)
+	}
+}
+
+func (p *memStatePlugin) GetBalance(addr common.Address) *big.Int {
+	if acc, ok := p.accounts[addr]; ok {
+		return new(big.Int).Set(acc.balance)
+	}
+	return new(big.Int)
+}
+
+func (p *memStatePlugin) SetBalance(addr common.Address, amount *big.Int) {
+	p.account(addr).balance = new(big.Int).Set(amount)
+}
+
+func (p *memStatePlugin) SubBalance(addr common.Address, amount *big.Int) {
+	acc := p.account(addr)
+	acc.balance = new(big.Int).Sub(acc.balance, amount)
+}
+
+func (p *memStatePlugin) AddBalance(addr common.Address, amount *big.Int) {
+	acc := p.account(addr)
+	acc.balance = new(big.Int).Add(acc.balance, amount)
+}
+
+func (p *memStatePlugin) GetNonce(addr common.Address) uint64 {
+	if acc, ok := p.accounts[addr]; ok {
+		return acc.nonce
+	}
+	return 0
+}
+
+func (p *memStatePlugin) SetNonce(addr common.Address, nonce uint64) {
+	p.account(addr).nonce = nonce
+}
+
+func (p *memStatePlugin) GetCodeHash(addr common.Address) common.Hash {
+	if acc, ok := p.accounts[addr]; ok {
+		return crypto.Keccak256Hash(acc.code)
+	}
+	return common.Hash{}
+}
+
+func (p *memStatePlugin) GetCode(addr common.Address) []byte {
+	if acc, ok := p.accounts[addr]; ok {
+		return acc.code
+	}
+	return nil
+}
+
+func (p *memStatePlugin) SetCode(addr common.Address, code []byte) {
+	p.account(addr).code = code
+}
+
+func (p *memStatePlugin) GetCommittedState(addr common.Address, key common.Hash) common.Hash {
+	return p.GetState(addr, key)
+}
+
+func (p *memStatePlugin) GetState(addr common.Address, key common.Hash) common.Hash {
+	if acc, ok := p.accounts[addr]; ok {
+		return acc.storage[key]
+	}
+	return common.Hash{}
+}
+
+func (p *memStatePlugin) SetState(addr common.Address, key, value common.Hash) {
+	p.account(addr).storage[key] = value
+}
+
+func (p *memStatePlugin) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	p.account(addr).storage = storage
+}
+
+func (p *memStatePlugin) ForEachStorage(
+	addr common.Address, cb func(common.Hash, common.Hash) bool,
+) error {
+	for k, v := range p.account(addr).storage {
+		if !cb(k, v) {
+			break
+		}
+	}
+	return nil
+}
@@ -32,7 +32,6 @@ type ChainSubscriber interface {
 	SubscribeChainHeadEvent(chan<- ChainHeadEvent) event.Subscription
 	SubscribeChainSideEvent(ch chan<- ChainSideEvent) event.Subscription // currently not used
 	SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription
-	SubscribePendingLogsEvent(ch chan<- []*types.Log) event.Subscription
 	SubscribeNewTxsEvent(ch chan<- NewTxsEvent) event.Subscription
 }
 
@@ -61,11 +60,6 @@ func (bc *blockchain) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscript
 	return bc.scope.Track(bc.logsFeed.Subscribe(ch))
 }
 
-// SubscribePendingLogsEvent registers a subscription of []*types.Log.
-func (bc *blockchain) SubscribePendingLogsEvent(ch chan<- []*types.Log) event.Subscription {
-	return bc.scope.Track(bc.pendingLogsFeed.Subscribe(ch))
-}
-
 func (bc *blockchain) SubscribeNewTxsEvent(ch chan<- NewTxsEvent) event.Subscription {
 	return bc.tp.SubscribeNewTxsEvent(ch)
 }
@@ -68,8 +68,8 @@ func (bc *blockchain) Prepare(ctx context.Context, number uint64) {
 		parent = bc.GetHeaderByNumber(number - 1)
 	}
 
-	// Polaris does not set Ethereum state root (Root), mix hash (MixDigest), extra data (Extra),
-	// and block nonce (Nonce) on the new header.
+	// Polaris does not set mix hash (MixDigest), extra data (Extra), and block nonce (Nonce) on the
+	// new header. The state root (Root) is set by the state processor when the block is finalized.
 	header := &types.Header{
 		// Used in Polaris.
 		ParentHash: parent.Hash(),
@@ -105,6 +105,11 @@ func (bc *blockchain) ProcessTransaction(ctx context.Context, tx *types.Transact
 
 // Finalize finalizes the current block.
 func (bc *blockchain) Finalize(ctx context.Context) error {
+	// attach the logs of the host chain to the block, if supported
+	if hlp, ok := bc.bp.(HostLogsProvider); ok {
+		bc.processor.AddHostLogs(hlp.HostLogs())
+	}
+
 	block, receipts, logs, err := bc.processor.Finalize(ctx)
 	if err != nil {
 		return err
@@ -134,6 +139,22 @@ func (bc *blockchain) Finalize(ctx context.Context) error {
 			bc.logger.Error("failed to store transactions", "err", err)
 			return err
 		}
+
+		// prune the historical data that is outside of the retention policy, if supported
+		if pruner, ok := bc.hp.(HistoricalPruner); ok {
+			if err = pruner.Prune(block); err != nil {
+				bc.logger.Error("failed to prune historical data", "err", err)
+				return err
+			}
+		}
+
+		// write the historical data of the block at once, if supported
+		if committer, ok := bc.hp.(HistoricalCommitter); ok {
+			if err = committer.Commit(); err != nil {
+				bc.logger.Error("failed to commit historical data", "err", err)
+				return err
+			}
+		}
 	}
 
 	// mark the current block, receipts, and logs
@@ -164,7 +185,6 @@ func (bc *blockchain) Finalize(ctx context.Context) error {
 		bc.receiptsCache.Add(blockHash, receipts)
 	}
 	if logs != nil {
-		bc.pendingLogsFeed.Send(logs)
 		bc.currentLogs.Store(logs)
 		if len(logs) > 0 {
 			bc.logsFeed.Send(logs)
@@ -28,4 +28,7 @@ var (
 	ErrHeaderNotFound   = errors.New("header not found")
 	ErrReceiptsNotFound = errors.New("receipts not found")
 	ErrTxNotFound       = errors.New("transaction not found")
+	ErrHistoryPruned    = errors.New("history pruned")
+	ErrSendNotAllowed   = errors.New("sender is not allowed to send transactions")
+	ErrCreateNotAllowed = errors.New("sender is not allowed to create contracts")
 )
@@ -21,6 +21,7 @@
 package core
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/event"
@@ -77,6 +78,14 @@ type (
 		BaseFee() *big.Int
 	}
 
+	// HostLogsProvider defines the methods that a `BlockPlugin` can OPTIONALLY implement in
+	// order to attach logs that did not originate from the EVM (e.g. events of the host chain)
+	// to the block, as a synthetic receipt following the receipts of the transactions.
+	HostLogsProvider interface {
+		// HostLogs returns the logs to attach to the block that is being finalized.
+		HostLogs() []*types.Log
+	}
+
 	// ConfigurationPlugin defines the methods that the chain running Polaris EVM should
 	// implement in order to configuration the parameters of the Polaris EVM.
 	ConfigurationPlugin interface {
@@ -87,6 +96,18 @@ type (
 		ChainConfig() *params.ChainConfig
 	}
 
+	// AccessController defines the methods that a `ConfigurationPlugin` can OPTIONALLY implement
+	// in order to restrict the accounts that can send transactions and create contracts. A
+	// transaction is rejected if its sender is not allowed to send it, or if it creates a contract
+	// (including with CREATE or CREATE2 inside the EVM) while its sender is not allowed to.
+	AccessController interface {
+		// CanSendTransaction returns whether the given account is allowed to send transactions.
+		CanSendTransaction(common.Address) bool
+		// CanCreateContract returns whether the given account is allowed to create contracts, in
+		// the transactions that it sends.
+		CanCreateContract(common.Address) bool
+	}
+
 	// GasPlugin is an interface that allows the Polaris EVM to consume gas on the host chain.
 	GasPlugin interface {
 		// GasPlugin implements `libtypes.Preparable`. Calling `Prepare` should reset the
@@ -173,8 +194,45 @@ type (
 		StoreTransactions(uint64, common.Hash, types.Transactions) error
 	}
 
+	// HistoricalPruner defines the methods that a `HistoricalPlugin` can OPTIONALLY implement in
+	// order to enforce a retention policy on the blocks, receipts, and transactions it stores.
+	// Lookups of pruned data should return `ErrHistoryPruned`, so that pruned data can be told
+	// apart from data that was never found.
+	HistoricalPruner interface {
+		// Prune removes the historical data that falls outside of the retention policy, given
+		// the block that is being finalized.
+		Prune(*types.Block) error
+		// EarliestBlockNumber returns the number of the earliest block that has not been pruned.
+		EarliestBlockNumber() uint64
+		// IsPruned returns whether the block or transaction with the given hash has been pruned.
+		IsPruned(common.Hash) bool
+	}
+
+	// HistoricalCommitter defines the methods that a `HistoricalPlugin` can OPTIONALLY implement in
+	// order to write the historical data of each block atomically. The blocks, receipts, and
+	// transactions stored (and pruned) when finalizing a block are only written on `Commit`.
+	HistoricalCommitter interface {
+		// Commit writes the historical data stored since the last commit.
+		Commit() error
+	}
+
 	// PrecompilePlugin defines the methods that the chain running Polaris EVM should implement
 	// in order to support running their own stateful precompiled contracts. Implementing this
 	// plugin is optional.
 	PrecompilePlugin = precompile.Plugin
+
+	// DynamicPrecompileProvider defines the methods that a `PrecompilePlugin` can OPTIONALLY
+	// implement in order to activate and deactivate precompiles at runtime (e.g. by governance),
+	// instead of only when the chain is constructed. The state processor registers and removes
+	// the dynamic precompiles with the plugin when it prepares a block.
+	DynamicPrecompileProvider interface {
+		// GetDynamicPrecompiles returns the dynamic precompiles that are active at the block of
+		// the given number, indexed by the address at which they are active. The given context
+		// is the context of the state plugin for the block.
+		GetDynamicPrecompiles(
+			ctx context.Context, number uint64,
+		) map[common.Address]precompile.DynamicImpl
+		// Remove removes the precompile at the given address from the plugin.
+		Remove(common.Address)
+	}
 )
@@ -99,6 +99,11 @@ func (c *baseContract) CustomValueDecoders() ValueDecoders {
 	return nil
 }
 
+// GasSchedule implements StatefulImpl.
+func (c *baseContract) GasSchedule() GasSchedule {
+	return nil
+}
+
 // SetPlugin implements BaseContract.
 func (c *baseContract) SetPlugin(plugin Plugin) {
 	c.plugin = plugin
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"testing"
+
+	solidity "pkg.berachain.dev/polaris/contracts/bindings/testing"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	vmmock "pkg.berachain.dev/polaris/eth/core/vm/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+//go:generate go run ./precompilegen --abi ../../../contracts/out/MockPrecompileInterface.sol/MockPrecompileInterface.abi.json --pkg precompile_test --type mockDispatchPrecompile --bindings pkg.berachain.dev/polaris/contracts/bindings/testing --out ./mock_dispatch.precompilegen_test.go
+
+var _ = Describe("Generated Dispatcher", func() {
+	var (
+		ctx        context.Context
+		reflective vm.PrecompileContainer
+		generated  vm.PrecompileContainer
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		reflective, err = precompile.NewStatefulFactory().Build(newMockPrecompile(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		generated, err = precompile.NewStatefulFactory().Build(
+			&mockDispatchPrecompile{newMockPrecompile()}, nil,
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should return the same outputs as the reflective dispatch", func() {
+		for _, input := range mockInputs() {
+			expected, err := reflective.Run(ctx, vmmock.NewEVM(), input, common.Address{}, big.NewInt(0))
+			Expect(err).ToNot(HaveOccurred())
+			ret, err := generated.Run(ctx, vmmock.NewEVM(), input, common.Address{}, big.NewInt(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(Equal(expected))
+		}
+	})
+
+	It("should return the same errors as the reflective dispatch", func() {
+		getOutputPartial := mockABI.Methods["getOutputPartial"].ID
+
+		_, expected := reflective.Run(ctx, vmmock.NewEVM(), getOutputPartial, common.Address{}, nil)
+		Expect(expected).To(MatchError(vm.ErrExecutionReverted))
+		_, err := generated.Run(ctx, vmmock.NewEVM(), getOutputPartial, common.Address{}, nil)
+		Expect(err).To(MatchError(expected.Error()))
+
+		// unpacking error
+		_, err = generated.Run(
+			ctx, vmmock.NewEVM(), append(mockABI.Methods["getOutput"].ID, 1, 2), common.Address{}, nil,
+		)
+		Expect(err).To(HaveOccurred())
+
+		// method not found
+		_, err = generated.Run(ctx, vmmock.NewEVM(), []byte{1, 2, 3, 4}, common.Address{}, nil)
+		Expect(err).To(MatchError(precompile.ErrMethodNotFound))
+	})
+
+	It("should fail when the ABI does not match the generated dispatcher", func() {
+		stale := &mockDispatchPrecompile{&mockPrecompile{
+			BaseContract: staleBaseContract{newMockPrecompile().BaseContract},
+		}}
+
+		// the argument is unpacked with another type
+		_, err := stale.Dispatch(ctx, mockInputs()["contractFunc"])
+		Expect(err).To(MatchError(precompile.ErrInvalidArgument))
+
+		// the method is missing from the ABI
+		_, err = stale.Dispatch(ctx, mockInputs()["contractFuncStr"])
+		Expect(err).To(MatchError(precompile.ErrInvalidArgument))
+	})
+})
+
+// BenchmarkStatefulContainer compares the reflective and generated dispatch of the mock precompile
+// methods.
+func BenchmarkStatefulContainer(b *testing.B) {
+	reflective, err := precompile.NewStatefulFactory().Build(newMockPrecompile(), nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	generated, err := precompile.NewStatefulFactory().Build(
+		&mockDispatchPrecompile{newMockPrecompile()}, nil,
+	)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	ctx := context.Background()
+	evm := vmmock.NewEVM()
+	for _, dispatch := range []struct {
+		name string
+		pc   vm.PrecompileContainer
+	}{
+		{"reflection", reflective},
+		{"generated", generated},
+	} {
+		inputs := mockInputs()
+		names := make([]string, 0, len(inputs))
+		for name := range inputs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			dispatch, input := dispatch, inputs[name]
+			b.Run(dispatch.name+"/"+name, func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if _, err = dispatch.pc.Run(ctx, evm, input, common.Address{}, nil); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+var mockABI, _ = solidity.MockPrecompileMetaData.GetAbi()
+
+// mockInputs returns the inputs of the successful mock precompile methods.
+func mockInputs() map[string][]byte {
+	inputs := make(map[string][]byte)
+	for name, args := range map[string][]any{
+		"contractFunc":    {common.HexToAddress("0x1")},
+		"contractFuncStr": {"string"},
+		"getOutput":       {"string"},
+		"overloadedFunc":  {},
+		"overloadedFunc0": {big.NewInt(1)},
+	} {
+		input, err := mockABI.Pack(name, args...)
+		if err != nil {
+			panic(err)
+		}
+		inputs[name] = input
+	}
+	return inputs
+}
+
+// mockPrecompile is a stateful precompile of the mock precompile interface, which is dispatched
+// by reflection.
+type mockPrecompile struct {
+	precompile.BaseContract
+}
+
+func newMockPrecompile() *mockPrecompile {
+	return &mockPrecompile{
+		BaseContract: precompile.NewBaseContract(
+			solidity.MockPrecompileMetaData.ABI, common.HexToAddress("0x696969696969"),
+		),
+	}
+}
+
+func (mp *mockPrecompile) GetOutput(
+	_ context.Context, str string,
+) ([]solidity.MockPrecompileInterfaceObject, error) {
+	return []solidity.MockPrecompileInterfaceObject{
+		{CreationHeight: big.NewInt(1), TimeStamp: str},
+	}, nil
+}
+
+func (mp *mockPrecompile) GetOutputPartial(
+	_ context.Context,
+) (solidity.MockPrecompileInterfaceObject, error) {
+	return solidity.MockPrecompileInterfaceObject{}, errors.New("err during precompile execution")
+}
+
+func (mp *mockPrecompile) ContractFunc(_ context.Context, addr common.Address) (*big.Int, error) {
+	return new(big.Int).SetBytes(addr.Bytes()), nil
+}
+
+func (mp *mockPrecompile) ContractFuncStr(_ context.Context, str string) (bool, error) {
+	return str != "", nil
+}
+
+func (mp *mockPrecompile) OverloadedFunc(_ context.Context) (*big.Int, error) {
+	return big.NewInt(69), nil
+}
+
+func (mp *mockPrecompile) OverloadedFunc0(_ context.Context, a *big.Int) (*big.Int, error) {
+	return new(big.Int).Add(a, big.NewInt(420)), nil
+}
+
+// mockDispatchPrecompile is the mock precompile, which is dispatched by the generated dispatcher.
+type mockDispatchPrecompile struct {
+	*mockPrecompile
+}
+
+// staleBaseContract is a base contract whose ABI no longer matches the generated dispatcher of the
+// mock precompile.
+type staleBaseContract struct {
+	precompile.BaseContract
+}
+
+func (sbc staleBaseContract) ABIMethods() map[string]abi.Method {
+	methods := make(map[string]abi.Method)
+	for name, method := range sbc.BaseContract.ABIMethods() {
+		methods[name] = method
+	}
+
+	uint256, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	contractFunc := methods["contractFunc"]
+	contractFunc.Inputs = abi.Arguments{{Name: "addr", Type: uint256}}
+	methods["contractFunc"] = contractFunc
+	delete(methods, "contractFuncStr")
+	return methods
+}
@@ -41,4 +41,16 @@ var (
 	// ErrNoPrecompileMethodForABIMethod is returned when no precompile method is provided for a
 	// corresponding ABI method.
 	ErrNoPrecompileMethodForABIMethod = errors.New("this ABI method does not have a corresponding precompile method")
+
+	// ErrNoABIMethodForGasSchedule is returned when a precompile's gas schedule contains a method
+	// that is not in the precompile's ABI.
+	ErrNoABIMethodForGasSchedule = errors.New("the gas schedule contains a method that is not in the ABI")
+
+	// ErrInvalidSpecialMethod is returned when the `Receive` or `Fallback` method of a precompile
+	// does not have the expected signature.
+	ErrInvalidSpecialMethod = errors.New("the receive or fallback method has an invalid signature")
+
+	// ErrInvalidArgument is returned by a generated dispatcher when an unpacked argument does not
+	// have the type that the dispatcher was generated for, i.e. the dispatcher is stale.
+	ErrInvalidArgument = errors.New("the unpacked argument does not match the generated dispatcher")
 )
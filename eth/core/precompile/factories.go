@@ -100,12 +100,32 @@ func (sf *StatefulFactory) Build(
 	si.SetPlugin(p)
 
 	// add precompile methods to stateful container, if any exist
-	idsToMethods, err := buildIdsToMethods(si, reflect.ValueOf(si))
+	var (
+		idsToMethods map[string]*method
+		err          error
+	)
+	if _, ok := si.(DispatchImpl); ok {
+		// the generated dispatcher is type-safe at compile time, so no reflection is needed
+		idsToMethods = buildDispatchIdsToMethods(si)
+	} else {
+		idsToMethods, err = buildIdsToMethods(si, reflect.ValueOf(si))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// set the static gas costs of the precompile methods, if any are provided
+	if err = applyGasSchedule(si, idsToMethods); err != nil {
+		return nil, err
+	}
+
+	// add the receive and fallback methods to stateful container, if they exist
+	receive, fallback, err := buildReceiveAndFallback(reflect.ValueOf(si))
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStatefulContainer(si, idsToMethods)
+	return NewStatefulContainer(si, idsToMethods, receive, fallback)
 }
 
 // This function matches each Go implementation of the precompile to the ABI's respective function.
@@ -139,3 +159,60 @@ func buildIdsToMethods(si StatefulImpl, contractImpl reflect.Value) (map[string]
 
 	return idsToMethods, nil
 }
+
+// This function maps the ID of each ABI method to a method without a Go implementation, as the
+// methods of a `DispatchImpl` precompile are executed by its generated dispatcher.
+func buildDispatchIdsToMethods(si StatefulImpl) map[string]*method {
+	idsToMethods := make(map[string]*method)
+	for _, abiMethod := range si.ABIMethods() {
+		idsToMethods[utils.UnsafeBytesToStr(abiMethod.ID)] = newMethod(si, abiMethod, reflect.Method{})
+	}
+	return idsToMethods
+}
+
+// This function sets the static gas cost of each method in the precompile's gas schedule. It
+// returns an error if the gas schedule contains a method that is not in the ABI.
+func applyGasSchedule(si StatefulImpl, idsToMethods map[string]*method) error {
+	precompileABI := si.ABIMethods()
+	for methodName, gas := range si.GasSchedule() {
+		abiMethod, found := precompileABI[methodName]
+		if !found {
+			return errorslib.Wrap(ErrNoABIMethodForGasSchedule, methodName)
+		}
+		idsToMethods[utils.UnsafeBytesToStr(abiMethod.ID)].gas = gas
+	}
+	return nil
+}
+
+// This function finds the optional `Receive` and `Fallback` methods of the Go precompile contract
+// and validates that their signatures match the expected executables.
+func buildReceiveAndFallback(contractImpl reflect.Value) (receiveMethod, fallbackMethod, error) {
+	var (
+		receive  receiveMethod
+		fallback fallbackMethod
+	)
+
+	if implMethod := contractImpl.MethodByName(receiveMethodName); implMethod.IsValid() {
+		if !implMethod.Type().ConvertibleTo(reflect.TypeOf(receive)) {
+			return nil, nil, errorslib.Wrapf(
+				ErrInvalidSpecialMethod, "%s has type %v", receiveMethodName, implMethod.Type(),
+			)
+		}
+		receive = utils.MustGetAs[receiveMethod](
+			implMethod.Convert(reflect.TypeOf(receive)).Interface(),
+		)
+	}
+
+	if implMethod := contractImpl.MethodByName(fallbackMethodName); implMethod.IsValid() {
+		if !implMethod.Type().ConvertibleTo(reflect.TypeOf(fallback)) {
+			return nil, nil, errorslib.Wrapf(
+				ErrInvalidSpecialMethod, "%s has type %v", fallbackMethodName, implMethod.Type(),
+			)
+		}
+		fallback = utils.MustGetAs[fallbackMethod](
+			implMethod.Convert(reflect.TypeOf(fallback)).Interface(),
+		)
+	}
+
+	return receive, fallback, nil
+}
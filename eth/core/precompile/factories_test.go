@@ -87,6 +87,69 @@ var _ = Describe("Container Factories", func() {
 		})
 	})
 
+	Context("Gas Scheduled Stateful Container", func() {
+		var scf *StatefulFactory
+
+		BeforeEach(func() {
+			scf = NewStatefulFactory()
+		})
+
+		It("should charge the static gas of the scheduled methods", func() {
+			pc, err := scf.Build(&mockStatefulWithGas{&mockStateful{&mockBase{}}}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			// 3 words of ABI encoded string argument
+			inputs, err := getOutputABI.Inputs.Pack("string")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pc.RequiredGas(append(getOutputABI.ID, inputs...))).To(Equal(uint64(1000 + 3*10)))
+
+			// no arguments
+			Expect(pc.RequiredGas(overloadedFuncABI.ID)).To(Equal(uint64(500)))
+
+			// not scheduled
+			Expect(pc.RequiredGas(getOutputPartialABI.ID)).To(Equal(uint64(0)))
+		})
+
+		It("should error on a gas schedule method that is not in the ABI", func() {
+			_, err := scf.Build(&badGasMockStateful{&mockStateful{&mockBase{}}}, nil)
+			Expect(err).To(MatchError(ErrNoABIMethodForGasSchedule))
+		})
+	})
+
+	Context("Receive and Fallback Stateful Container", func() {
+		var scf *StatefulFactory
+
+		BeforeEach(func() {
+			scf = NewStatefulFactory()
+		})
+
+		It("should build a stateful container with receive and fallback methods", func() {
+			pc, err := scf.Build(&mockStatefulWithFallback{&mockStateful{&mockBase{}}}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			sc, ok := pc.(*statefulContainer)
+			Expect(ok).To(BeTrue())
+			Expect(sc.receive).ToNot(BeNil())
+			Expect(sc.fallback).ToNot(BeNil())
+
+			pc, err = scf.Build(&mockStateful{&mockBase{}}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			sc, ok = pc.(*statefulContainer)
+			Expect(ok).To(BeTrue())
+			Expect(sc.receive).To(BeNil())
+			Expect(sc.fallback).To(BeNil())
+		})
+
+		It("should error on a receive method with an invalid signature", func() {
+			_, err := scf.Build(&badReceiveMockStateful{&mockStateful{&mockBase{}}}, nil)
+			Expect(err).To(MatchError(ErrInvalidSpecialMethod))
+		})
+
+		It("should error on a fallback method with an invalid signature", func() {
+			_, err := scf.Build(&badFallbackMockStateful{&mockStateful{&mockBase{}}}, nil)
+			Expect(err).To(MatchError(ErrInvalidSpecialMethod))
+		})
+	})
+
 	Context("Overloaded Stateful Container", func() {
 		It("should construct a stateful container with overloaded methods", func() {
 			scf := NewStatefulFactory()
@@ -118,6 +181,8 @@ func (mb *mockBase) ABIEvents() map[string]abi.Event { return nil }
 // logic.
 func (mb *mockBase) CustomValueDecoders() ValueDecoders { return nil }
 
+func (mb *mockBase) GasSchedule() GasSchedule { return nil }
+
 func (mb *mockBase) SetPlugin(_ Plugin) {}
 
 // ============================================================================.
@@ -211,3 +276,61 @@ func (bms *badMockStateful) ABIMethods() map[string]abi.Method {
 		"getOutputPartial": mock.Methods["getOutputPartial"],
 	}
 }
+
+// ============================================================================.
+type mockStatefulWithFallback struct {
+	*mockStateful
+}
+
+func (ms *mockStatefulWithFallback) Receive(ctx context.Context) error {
+	if vm.UnwrapPolarContext(ctx).MsgValue().Sign() == 0 {
+		return errors.New("no value received")
+	}
+	return nil
+}
+
+func (ms *mockStatefulWithFallback) Fallback(_ context.Context, input []byte) ([]byte, error) {
+	if len(input) == 1 {
+		return nil, errors.New("invalid fallback input")
+	}
+	return input, nil
+}
+
+// ============================================================================.
+type badReceiveMockStateful struct {
+	*mockStateful
+}
+
+func (bms *badReceiveMockStateful) Receive(_ context.Context) (bool, error) {
+	return true, nil
+}
+
+// ============================================================================.
+type badFallbackMockStateful struct {
+	*mockStateful
+}
+
+func (bms *badFallbackMockStateful) Fallback(_ context.Context) ([]byte, error) {
+	return nil, nil
+}
+
+// ============================================================================.
+type mockStatefulWithGas struct {
+	*mockStateful
+}
+
+func (ms *mockStatefulWithGas) GasSchedule() GasSchedule {
+	return GasSchedule{
+		"getOutput":      {Base: 1000, PerInputWord: 10},
+		"overloadedFunc": {Base: 500, PerInputWord: 10},
+	}
+}
+
+// ============================================================================.
+type badGasMockStateful struct {
+	*mockStateful
+}
+
+func (bms *badGasMockStateful) GasSchedule() GasSchedule {
+	return GasSchedule{"notAMethod": {Base: 1000}}
+}
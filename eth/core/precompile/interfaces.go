@@ -21,6 +21,8 @@
 package precompile
 
 import (
+	"context"
+
 	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/vm"
@@ -86,9 +88,25 @@ type (
 		// logic.
 		CustomValueDecoders() ValueDecoders
 
+		// GasSchedule should return a map of ABI method names to the static gas costs of the
+		// methods, which are charged before a method is executed. Methods that are not in the
+		// schedule have no static gas cost.
+		GasSchedule() GasSchedule
+
 		SetPlugin(Plugin)
 	}
 
+	// DispatchImpl is the interface for stateful precompiled contracts whose ABI methods are
+	// executed by a generated, type-safe dispatcher (see the `precompilegen` tool) instead of by
+	// reflection.
+	DispatchImpl interface {
+		StatefulImpl
+
+		// Dispatch executes the ABI method whose ID is the first 4 bytes of the input, with the
+		// ABI encoded arguments in the rest of the input, and returns the ABI encoded outputs.
+		Dispatch(ctx context.Context, input []byte) ([]byte, error)
+	}
+
 	// DynamicImpl is the interface for all dynamic stateful precompiled contracts.
 	DynamicImpl interface {
 		StatefulImpl
@@ -107,3 +125,17 @@ type (
 	// functions.
 	ValueDecoders map[string]ValueDecoder
 )
+
+type (
+	// MethodGas is the static gas cost of a stateful precompile method. The dynamic gas cost of
+	// the method's execution (e.g. reading and writing state) is charged on top of it.
+	MethodGas struct {
+		// Base is the gas charged for every call of the method.
+		Base uint64
+		// PerInputWord is the gas charged for every 32 byte word of the method's ABI encoded
+		// arguments.
+		PerInputWord uint64
+	}
+	// GasSchedule is a type that represents a map of ABI method names to their static gas costs.
+	GasSchedule map[string]MethodGas
+)
@@ -23,8 +23,12 @@ package precompile
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
 	"reflect"
 
+	gethmath "github.com/ethereum/go-ethereum/common/math"
+
 	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	"pkg.berachain.dev/polaris/eth/core/vm"
 	errorslib "pkg.berachain.dev/polaris/lib/errors"
@@ -43,8 +47,27 @@ import (
  *          with 0, 1, 2, ... for every overloaded function. For example, if you have two functions
  *          named `foo` in your smart contract, then name the first function `foo` and the second
  *          `foo0`. We enforce the same overloading scheme that geth's abi package uses.
+ *	  3) Optionally, implement the `Receive` and `Fallback` methods, which have the signatures
+ *       `Receive(context.Context) error` and `Fallback(context.Context, []byte) ([]byte, error)`,
+ *       to handle calls with empty input and calls that do not match any ABI method.
  **/
 
+const (
+	// receiveMethodName is the name of the Go method that implements the receive function.
+	receiveMethodName = `Receive`
+	// fallbackMethodName is the name of the Go method that implements the fallback function.
+	fallbackMethodName = `Fallback`
+)
+
+type (
+	// receiveMethod is the executable of a precompile's receive function, which is called with
+	// empty input (e.g. plain ETH transfers).
+	receiveMethod func(ctx context.Context) error
+	// fallbackMethod is the executable of a precompile's fallback function, which is called with
+	// the raw input when it does not match any of the precompile's methods.
+	fallbackMethod func(ctx context.Context, input []byte) ([]byte, error)
+)
+
 // method is a struct that contains the required information for the EVM to execute a stateful
 // precompiled contract method.
 type method struct {
@@ -58,6 +81,9 @@ type method struct {
 	// Execute is the precompile's executable which will execute the logic of the implemented
 	// ABI method.
 	execute reflect.Method
+
+	// gas is the static gas cost of executing this method.
+	gas MethodGas
 }
 
 // newMethod creates and returns a new `method` with the given abiMethod, abiSig, and executable.
@@ -71,6 +97,23 @@ func newMethod(
 	}
 }
 
+// RequiredGas returns the static gas cost of executing this method with the given input, which
+// includes the method ID. The gas saturates at the max uint64, which exceeds any gas limit,
+// instead of overflowing.
+func (m *method) RequiredGas(input []byte) uint64 {
+	inputGas, overflow := gethmath.SafeMul(
+		m.gas.PerInputWord, toWordSize(uint64(len(input)-NumBytesMethodID)),
+	)
+	if overflow {
+		return math.MaxUint64
+	}
+	gas, overflow := gethmath.SafeAdd(m.gas.Base, inputGas)
+	if overflow {
+		return math.MaxUint64
+	}
+	return gas
+}
+
 // Call executes the precompile's executable with the given context and input arguments.
 func (m *method) Call(ctx context.Context, input []byte) ([]byte, error) {
 	// Unpack the args from the input, if any exist.
@@ -101,14 +144,7 @@ func (m *method) Call(ctx context.Context, input []byte) ([]byte, error) {
 		err = utils.MustGetAs[error](revert)
 	}
 	if err != nil {
-		if !errors.Is(err, vm.ErrWriteProtection) {
-			err = errorslib.Wrapf(
-				vm.ErrExecutionReverted,
-				"vm error [%v] occurred during precompile execution of [%s]",
-				err, m.abiMethod.Name,
-			)
-		}
-		return nil, err
+		return nil, WrapExecutionError(err, m.abiMethod.Name)
 	}
 
 	// Pack the return values and return, if any exist.
@@ -123,3 +159,31 @@ func (m *method) Call(ctx context.Context, input []byte) ([]byte, error) {
 
 	return ret, nil
 }
+
+// WrapExecutionError wraps an error returned by the precompile executable with the given name as
+// an execution revert, unless it is a write protection error. It is also used by the generated
+// dispatchers of `DispatchImpl` precompiles.
+func WrapExecutionError(err error, name string) error {
+	if errors.Is(err, vm.ErrWriteProtection) {
+		return err
+	}
+	return errorslib.Wrapf(
+		vm.ErrExecutionReverted,
+		"vm error [%v] occurred during precompile execution of [%s]",
+		err, name,
+	)
+}
+
+// InvalidArgumentError returns the error of a generated dispatcher whose unpacked argument at the
+// given index of the method with the given name is of an unexpected type.
+func InvalidArgumentError(name string, index int, arg any) error {
+	return fmt.Errorf("%w: argument %d of [%s] is of type %T", ErrInvalidArgument, index, name, arg)
+}
+
+// toWordSize returns the ceiled number of 32 byte words needed to store the given number of bytes.
+func toWordSize(size uint64) uint64 {
+	if size > math.MaxUint64-31 {
+		return math.MaxUint64/32 + 1
+	}
+	return (size + 31) / 32
+}
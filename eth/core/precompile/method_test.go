@@ -22,6 +22,7 @@ package precompile
 
 import (
 	"context"
+	"math"
 	"math/big"
 	"reflect"
 
@@ -62,6 +63,27 @@ var _ = Describe("Method", func() {
 			Expect(sc.executableCalled).To(BeTrue())
 		})
 	})
+
+	Context("Computing the required gas", func() {
+		var m *method
+
+		BeforeEach(func() {
+			m = newMethod(&mockStatefulWithMethod{&mockBase{}, false}, abi.Method{}, reflect.Method{})
+		})
+
+		It("should charge the base and the input words", func() {
+			m.gas = MethodGas{Base: 100, PerInputWord: 10}
+			Expect(m.RequiredGas(make([]byte, NumBytesMethodID+33))).To(Equal(uint64(120)))
+		})
+
+		It("should saturate instead of overflowing", func() {
+			m.gas = MethodGas{Base: 1, PerInputWord: math.MaxUint64}
+			Expect(m.RequiredGas(make([]byte, NumBytesMethodID+1))).To(Equal(uint64(math.MaxUint64)))
+
+			m.gas = MethodGas{Base: math.MaxUint64, PerInputWord: 1}
+			Expect(m.RequiredGas(make([]byte, NumBytesMethodID+1))).To(Equal(uint64(math.MaxUint64)))
+		})
+	})
 })
 
 // MOCKS BELOW.
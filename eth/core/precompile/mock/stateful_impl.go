@@ -43,5 +43,8 @@ func NewStatefulImpl() *StatefulImplMock {
 		CustomValueDecodersFunc: func() precompile.ValueDecoders {
 			return nil
 		},
+		GasScheduleFunc: func() precompile.GasSchedule {
+			return nil
+		},
 	}
 }
@@ -25,6 +25,9 @@ import (
 //			CustomValueDecodersFunc: func() precompile.ValueDecoders {
 //				panic("mock out the CustomValueDecoders method")
 //			},
+//			GasScheduleFunc: func() precompile.GasSchedule {
+//				panic("mock out the GasSchedule method")
+//			},
 //			RegistryKeyFunc: func() common.Address {
 //				panic("mock out the RegistryKey method")
 //			},
@@ -47,6 +50,9 @@ type StatefulImplMock struct {
 	// CustomValueDecodersFunc mocks the CustomValueDecoders method.
 	CustomValueDecodersFunc func() precompile.ValueDecoders
 
+	// GasScheduleFunc mocks the GasSchedule method.
+	GasScheduleFunc func() precompile.GasSchedule
+
 	// RegistryKeyFunc mocks the RegistryKey method.
 	RegistryKeyFunc func() common.Address
 
@@ -64,6 +70,9 @@ type StatefulImplMock struct {
 		// CustomValueDecoders holds details about calls to the CustomValueDecoders method.
 		CustomValueDecoders []struct {
 		}
+		// GasSchedule holds details about calls to the GasSchedule method.
+		GasSchedule []struct {
+		}
 		// RegistryKey holds details about calls to the RegistryKey method.
 		RegistryKey []struct {
 		}
@@ -76,6 +85,7 @@ type StatefulImplMock struct {
 	lockABIEvents           sync.RWMutex
 	lockABIMethods          sync.RWMutex
 	lockCustomValueDecoders sync.RWMutex
+	lockGasSchedule         sync.RWMutex
 	lockRegistryKey         sync.RWMutex
 	lockSetPlugin           sync.RWMutex
 }
@@ -161,6 +171,33 @@ func (mock *StatefulImplMock) CustomValueDecodersCalls() []struct {
 	return calls
 }
 
+// GasSchedule calls GasScheduleFunc.
+func (mock *StatefulImplMock) GasSchedule() precompile.GasSchedule {
+	if mock.GasScheduleFunc == nil {
+		panic("StatefulImplMock.GasScheduleFunc: method is nil but StatefulImpl.GasSchedule was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGasSchedule.Lock()
+	mock.calls.GasSchedule = append(mock.calls.GasSchedule, callInfo)
+	mock.lockGasSchedule.Unlock()
+	return mock.GasScheduleFunc()
+}
+
+// GasScheduleCalls gets all the calls that were made to GasSchedule.
+// Check the length with:
+//
+//	len(mockedStatefulImpl.GasScheduleCalls())
+func (mock *StatefulImplMock) GasScheduleCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGasSchedule.RLock()
+	calls = mock.calls.GasSchedule
+	mock.lockGasSchedule.RUnlock()
+	return calls
+}
+
 // RegistryKey calls RegistryKeyFunc.
 func (mock *StatefulImplMock) RegistryKey() common.Address {
 	if mock.RegistryKeyFunc == nil {
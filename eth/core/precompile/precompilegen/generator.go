@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+const (
+	// bindingsAlias is the import alias of the abigen bindings package, which provides the Go
+	// structs of the ABI tuples.
+	bindingsAlias = "generated"
+
+	// the import paths of the packages that the generated code may use.
+	abiImport        = "pkg.berachain.dev/polaris/eth/accounts/abi"
+	bigImport        = "math/big"
+	commonImport     = "pkg.berachain.dev/polaris/eth/common"
+	contextImport    = "context"
+	precompileImport = "pkg.berachain.dev/polaris/eth/core/precompile"
+	utilsImport      = "pkg.berachain.dev/polaris/lib/utils"
+)
+
+var (
+	// ErrNoOutputs is returned when an ABI method has no outputs, which precompile methods must
+	// have.
+	ErrNoOutputs = errors.New("precompile methods must return at least one value")
+	// ErrNoBindings is returned when an ABI method uses tuples, but no bindings package is given.
+	ErrNoBindings = errors.New("a bindings package is required for ABI tuples")
+)
+
+// dispatcherTemplate is the template of the generated dispatcher file.
+var dispatcherTemplate = template.Must(template.New("dispatcher").Parse(`// Code generated by precompilegen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+{{- range .Imports }}
+	{{ . }}
+{{- end }}
+)
+
+// Compile-time assertion to ensure {{ .Type }} is dispatched by the generated dispatcher.
+var _ precompile.DispatchImpl = (*{{ .Type }})(nil)
+
+// Dispatch executes the ABI method whose ID is the first 4 bytes of the input.
+//
+// Dispatch implements ` + "`precompile.DispatchImpl`" + `.
+func ({{ .Receiver }} *{{ .Type }}) Dispatch(ctx context.Context, input []byte) ([]byte, error) {
+	if len(input) < precompile.NumBytesMethodID {
+		return nil, precompile.ErrInvalidInputToPrecompile
+	}
+
+	methods := {{ .Receiver }}.ABIMethods()
+	switch utils.UnsafeBytesToStr(input[:precompile.NumBytesMethodID]) {
+{{- range .Methods }}
+	case "{{ .ID }}": // {{ .Sig }}
+		return {{ $.Receiver }}.{{ .Handler }}(ctx, methods["{{ .Name }}"], input)
+{{- end }}
+	default:
+		return nil, precompile.ErrMethodNotFound
+	}
+}
+{{ range .Methods }}
+// {{ .Handler }} executes the ` + "`{{ .Sig }}`" + ` method.
+func ({{ $.Receiver }} *{{ $.Type }}) {{ .Handler }}(
+	ctx context.Context, method abi.Method, input []byte,
+) ([]byte, error) {
+{{- if .Args }}
+	args, err := method.Inputs.Unpack(input[precompile.NumBytesMethodID:])
+	if err != nil {
+		return nil, err
+	}
+	if len(args) != {{ len .Args }} {
+		return nil, precompile.ErrInvalidArgument
+	}
+{{- range $i, $arg := .Args }}
+{{- if .Tuple }}
+	arg{{ $i }}, ok := abi.ConvertType(args[{{ $i }}], new({{ .Type }})).(*{{ .Type }})
+{{- else }}
+	arg{{ $i }}, ok := args[{{ $i }}].({{ .Type }})
+{{- end }}
+	if !ok {
+		return nil, precompile.InvalidArgumentError(method.Name, {{ $i }}, args[{{ $i }}])
+	}
+{{- end }}
+
+{{ end -}}
+	{{ .Returns }}, err := {{ $.Receiver }}.{{ .GoName }}(
+		ctx,
+{{- range $i, $arg := .Args }}
+		{{ if .Tuple }}*{{ end }}arg{{ $i }},
+{{- end }}
+	)
+	if err != nil {
+		return nil, precompile.WrapExecutionError(err, method.Name)
+	}
+
+	return method.Outputs.Pack({{ .Returns }})
+}
+{{ end }}`))
+
+type (
+	// dispatcher is the data of the generated dispatcher file.
+	dispatcher struct {
+		Package  string
+		Imports  []string
+		Type     string
+		Receiver string
+		Methods  []dispatchedMethod
+	}
+
+	// dispatchedMethod is the data of a generated method handler.
+	dispatchedMethod struct {
+		// ID is the method ID, as an escaped Go string.
+		ID string
+		// Name is the name of the ABI method, and Sig is its signature.
+		Name, Sig string
+		// GoName is the name of the Go method that implements the ABI method, and Handler is the
+		// name of the generated handler that calls it.
+		GoName, Handler string
+		// Args are the arguments of the method, asserted from the unpacked values.
+		Args []dispatchedArg
+		// Returns are the comma-separated names of the return values.
+		Returns string
+	}
+
+	// dispatchedArg is the data of an argument of a generated method handler.
+	dispatchedArg struct {
+		// Type is the Go type of the argument.
+		Type string
+		// Tuple is true iff the argument is or contains a tuple, which is unpacked as an anonymous
+		// struct that must be converted to the bindings struct.
+		Tuple bool
+	}
+)
+
+// generate returns the source code of the dispatcher of the given contract ABI for the Go type
+// `typeName` in the package `pkg`. The Go structs of the ABI tuples must be in the `bindings`
+// package, as generated by abigen.
+func generate(contractABI abi.ABI, pkg, typeName, bindings string) ([]byte, error) {
+	imports := map[string]struct{}{
+		contextImport:    {},
+		abiImport:        {},
+		precompileImport: {},
+		utilsImport:      {},
+	}
+
+	// sort the methods by name for a deterministic output
+	names := make([]string, 0, len(contractABI.Methods))
+	for name := range contractABI.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	methods := make([]dispatchedMethod, 0, len(names))
+	for _, name := range names {
+		abiMethod := contractABI.Methods[name]
+		if len(abiMethod.Outputs) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrNoOutputs, abiMethod.Sig)
+		}
+
+		goName := strings.ToUpper(name[:1]) + name[1:]
+		dm := dispatchedMethod{
+			ID:      escapeID(abiMethod.ID),
+			Name:    name,
+			Sig:     abiMethod.Sig,
+			GoName:  goName,
+			Handler: "dispatch" + goName,
+		}
+
+		for _, input := range abiMethod.Inputs {
+			goType, err := goTypeOf(input.Type, bindings, imports)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", abiMethod.Sig, err)
+			}
+			dm.Args = append(dm.Args, dispatchedArg{Type: goType, Tuple: containsTuple(input.Type)})
+		}
+
+		returns := make([]string, len(abiMethod.Outputs))
+		for i := range abiMethod.Outputs {
+			returns[i] = fmt.Sprintf("ret%d", i)
+		}
+		dm.Returns = strings.Join(returns, ", ")
+
+		methods = append(methods, dm)
+	}
+
+	var buf bytes.Buffer
+	if err := dispatcherTemplate.Execute(&buf, dispatcher{
+		Package:  pkg,
+		Imports:  sortImports(imports, bindings),
+		Type:     typeName,
+		Receiver: strings.ToLower(typeName[:1]),
+		Methods:  methods,
+	}); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// goTypeOf returns the Go type of the given ABI type, which is the type of the values unpacked by
+// geth's abi package, and adds the packages it uses to the imports.
+func goTypeOf(t abi.Type, bindings string, imports map[string]struct{}) (string, error) {
+	rt := t.GetType()
+	if rt == reflect.TypeOf(common.Address{}) {
+		imports[commonImport] = struct{}{}
+		return "common.Address", nil
+	}
+
+	//nolint:exhaustive // only the kinds used by geth's abi package.
+	switch rt.Kind() {
+	case reflect.Slice:
+		if t.Elem == nil {
+			return "[]byte", nil // bytes
+		}
+		elem, err := goTypeOf(*t.Elem, bindings, imports)
+		return "[]" + elem, err
+	case reflect.Array:
+		if t.Elem == nil {
+			return fmt.Sprintf("[%d]byte", t.Size), nil // fixed bytes
+		}
+		elem, err := goTypeOf(*t.Elem, bindings, imports)
+		return fmt.Sprintf("[%d]%s", t.Size, elem), err
+	case reflect.Struct:
+		if bindings == "" {
+			return "", ErrNoBindings
+		}
+		imports[bindings] = struct{}{}
+		return bindingsAlias + "." + t.TupleRawName, nil
+	case reflect.Ptr:
+		imports[bigImport] = struct{}{}
+		return "*big.Int", nil
+	default:
+		return rt.String(), nil
+	}
+}
+
+// containsTuple returns true iff the given ABI type is or contains a tuple, which is unpacked as
+// an anonymous struct that must be converted to the bindings struct.
+func containsTuple(t abi.Type) bool {
+	if t.Elem != nil {
+		return containsTuple(*t.Elem)
+	}
+	return len(t.TupleElems) > 0
+}
+
+// escapeID returns the given method ID as an escaped Go string literal.
+func escapeID(id []byte) string {
+	var sb strings.Builder
+	for _, b := range id {
+		fmt.Fprintf(&sb, `\x%02x`, b)
+	}
+	return sb.String()
+}
+
+// sortImports returns the quoted imports, with the standard library packages first and the
+// bindings package aliased.
+func sortImports(imports map[string]struct{}, bindings string) []string {
+	var std, others []string
+	for path := range imports {
+		switch {
+		case path == bindings:
+			others = append(others, fmt.Sprintf("%s %q", bindingsAlias, path))
+		case !strings.Contains(path, "."):
+			std = append(std, fmt.Sprintf("%q", path))
+		default:
+			others = append(others, fmt.Sprintf("%q", path))
+		}
+	}
+	sort.Strings(std)
+	sort.Slice(others, func(i, j int) bool {
+		return strings.TrimPrefix(others[i], bindingsAlias+" ") <
+			strings.TrimPrefix(others[j], bindingsAlias+" ")
+	})
+	if len(std) > 0 && len(others) > 0 {
+		std = append(std, "")
+	}
+	return append(std, others...)
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPrecompileGen(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "eth/core/precompile/precompilegen")
+}
+
+const (
+	bindings = "pkg.berachain.dev/polaris/contracts/bindings/testing"
+
+	tupleABI = `[{
+		"type": "function",
+		"name": "getObject",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "addr", "type": "address", "internalType": "address"},
+			{
+				"name": "objects",
+				"type": "tuple[]",
+				"internalType": "struct IFoo.Object[]",
+				"components": [
+					{"name": "amount", "type": "uint256", "internalType": "uint256"},
+					{"name": "data", "type": "bytes32", "internalType": "bytes32"}
+				]
+			}
+		],
+		"outputs": [{"name": "", "type": "uint64", "internalType": "uint64"}]
+	}]`
+
+	noOutputsABI = `[{
+		"type": "function",
+		"name": "doNothing",
+		"stateMutability": "nonpayable",
+		"inputs": [],
+		"outputs": []
+	}]`
+)
+
+var _ = Describe("Generator", func() {
+	It("should generate a dispatcher with typed arguments", func() {
+		contractABI, err := abi.JSON(strings.NewReader(tupleABI))
+		Expect(err).ToNot(HaveOccurred())
+
+		src, err := generate(contractABI, "foo", "Contract", bindings)
+		Expect(err).ToNot(HaveOccurred())
+
+		code := string(src)
+		Expect(code).To(HavePrefix("// Code generated by precompilegen. DO NOT EDIT."))
+		Expect(code).To(ContainSubstring("package foo"))
+		Expect(code).To(ContainSubstring(`generated "` + bindings + `"`))
+		Expect(code).To(ContainSubstring(`"pkg.berachain.dev/polaris/eth/common"`))
+		Expect(code).ToNot(ContainSubstring(`"math/big"`))
+		Expect(code).To(ContainSubstring(
+			`case "` + escapeID(contractABI.Methods["getObject"].ID) +
+				`": // getObject(address,(uint256,bytes32)[])`,
+		))
+		Expect(code).To(ContainSubstring("if len(args) != 2 {"))
+		Expect(code).To(ContainSubstring("arg0, ok := args[0].(common.Address)"))
+		Expect(code).To(ContainSubstring(
+			"arg1, ok := abi.ConvertType(args[1], new([]generated.IFooObject)).(*[]generated.IFooObject)",
+		))
+		Expect(code).To(ContainSubstring("precompile.InvalidArgumentError(method.Name, 1, args[1])"))
+		Expect(code).To(ContainSubstring("ret0, err := c.GetObject(\n\t\tctx,\n\t\targ0,\n\t\t*arg1,"))
+	})
+
+	It("should fail without the bindings of the ABI tuples", func() {
+		contractABI, err := abi.JSON(strings.NewReader(tupleABI))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = generate(contractABI, "foo", "Contract", "")
+		Expect(err).To(MatchError(ErrNoBindings))
+	})
+
+	It("should fail for methods without outputs", func() {
+		contractABI, err := abi.JSON(strings.NewReader(noOutputsABI))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = generate(contractABI, "foo", "Contract", "")
+		Expect(err).To(MatchError(ErrNoOutputs))
+	})
+
+	It("should map the ABI types to the unpacked Go types", func() {
+		for abiType, goType := range map[string]string{
+			"bool":      "bool",
+			"string":    "string",
+			"bytes":     "[]byte",
+			"bytes4":    "[4]byte",
+			"address":   "common.Address",
+			"uint8":     "uint8",
+			"int64":     "int64",
+			"uint256":   "*big.Int",
+			"int24":     "*big.Int",
+			"uint64[]":  "[]uint64",
+			"address[]": "[]common.Address",
+			"string[2]": "[2]string",
+		} {
+			t, err := abi.NewType(abiType, "", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(goTypeOf(t, "", make(map[string]struct{}))).To(Equal(goType), abiType)
+		}
+	})
+})
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// precompilegen generates a type-safe dispatcher for a stateful precompile from the ABI of its
+// Solidity interface, as built by forge. The dispatcher selects the precompile's Go method with a
+// switch on the method ID, and unpacks and packs its arguments and return values without
+// reflection.
+//
+// Usage:
+//
+//	precompilegen --abi ./IFoo.abi.json --pkg foo --type Contract --out ./foo.precompilegen.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+)
+
+func main() {
+	var (
+		abiPath  = flag.String("abi", "", "path to the ABI JSON of the Solidity interface")
+		pkg      = flag.String("pkg", "", "package name of the generated dispatcher")
+		typeName = flag.String("type", "Contract", "Go type of the precompile contract")
+		bindings = flag.String("bindings", "", "import path of the abigen bindings of the ABI tuples")
+		out      = flag.String("out", "", "output file of the generated dispatcher")
+	)
+	flag.Parse()
+
+	if err := run(*abiPath, *pkg, *typeName, *bindings, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "precompilegen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the dispatcher of the ABI at the given path and writes it to the output file.
+func run(abiPath, pkg, typeName, bindings, out string) error {
+	if abiPath == "" || pkg == "" || typeName == "" || out == "" {
+		flag.Usage()
+		return fmt.Errorf("the --abi, --pkg, --type and --out flags are required")
+	}
+
+	f, err := os.Open(abiPath) //#nosec: G304 // required.
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	contractABI, err := abi.JSON(f)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(contractABI, pkg, typeName, bindings)
+	if err != nil {
+		return err
+	}
+
+	//#nosec:G306 generated source code is not secret.
+	return os.WriteFile(out, src, 0o644)
+}
@@ -43,23 +43,38 @@ type statefulContainer struct {
 	// precompile creator and must exactly match the signature in the geth abi.Method.Sig field
 	// (geth abi format). Please check core/precompile/container/method.go for more information.
 	idsToMethods map[string]*method
-	// receive      *Method // TODO: implement
-	// fallback     *Method // TODO: implement
-
+	// dispatch is the generated dispatcher of the precompile's methods, if the precompile is a
+	// `DispatchImpl`. Otherwise, the methods are executed by reflection.
+	dispatch func(ctx context.Context, input []byte) ([]byte, error)
+	// receive is the optional `Receive` method of the precompile, which is executed for calls
+	// with empty input (e.g. plain ETH transfers).
+	receive receiveMethod
+	// fallback is the optional `Fallback` method of the precompile, which is executed for calls
+	// whose input does not match any method ID.
+	fallback fallbackMethod
 }
 
 // NewStatefulContainer creates and returns a new `statefulContainer` with the given method ids
-// precompile functions map.
+// precompile functions map and the optional receive and fallback methods.
 func NewStatefulContainer(
-	si StatefulImpl, idsToMethods map[string]*method,
+	si StatefulImpl,
+	idsToMethods map[string]*method,
+	receive receiveMethod,
+	fallback fallbackMethod,
 ) (vm.PrecompileContainer, error) {
 	if idsToMethods == nil {
 		return nil, ErrContainerHasNoMethods
 	}
-	return &statefulContainer{
+	sc := &statefulContainer{
 		StatefulImpl: si,
 		idsToMethods: idsToMethods,
-	}, nil
+		receive:      receive,
+		fallback:     fallback,
+	}
+	if di, ok := si.(DispatchImpl); ok {
+		sc.dispatch = di.Dispatch
+	}
+	return sc, nil
 }
 
 // Run loads the corresponding precompile method for given input, executes it, and handles
@@ -73,27 +88,64 @@ func (sc *statefulContainer) Run(
 	caller common.Address,
 	value *big.Int,
 ) ([]byte, error) {
+	polarCtx := vm.NewPolarContext(ctx, evm, caller, value)
+
+	// Calls with empty input are handled by the receive method, like in Solidity. If there is
+	// no receive method, the fallback method handles them instead.
+	if len(input) == 0 && sc.receive != nil {
+		if err := sc.receive(polarCtx); err != nil {
+			return nil, WrapExecutionError(err, receiveMethodName)
+		}
+		return nil, nil
+	}
+
 	if len(input) < NumBytesMethodID {
+		if sc.fallback != nil {
+			return sc.runFallback(polarCtx, input)
+		}
 		return nil, ErrInvalidInputToPrecompile
 	}
 
 	// Extract the method ID from the input and load the method.
 	method, found := sc.idsToMethods[utils.UnsafeBytesToStr(input[:NumBytesMethodID])]
 	if !found {
+		if sc.fallback != nil {
+			return sc.runFallback(polarCtx, input)
+		}
 		return nil, ErrMethodNotFound
 	}
 
+	// Execute the method with the generated dispatcher, if any
+	if sc.dispatch != nil {
+		return sc.dispatch(polarCtx, input)
+	}
+
 	// Execute the method with the reflected ctx and raw input
-	return method.Call(
-		vm.NewPolarContext(ctx, evm, caller, value),
-		input,
-	)
+	return method.Call(polarCtx, input)
+}
+
+// runFallback executes the fallback method of the precompile with the given raw input.
+func (sc *statefulContainer) runFallback(ctx context.Context, input []byte) ([]byte, error) {
+	ret, err := sc.fallback(ctx, input)
+	if err != nil {
+		return nil, WrapExecutionError(err, fallbackMethodName)
+	}
+	return ret, nil
 }
 
-// RequiredGas checks the Method corresponding to input for the required gas amount. TODO: remove
-// unneeded input from interface.
+// RequiredGas checks the Method corresponding to input for the required gas amount. Inputs that do
+// not correspond to a method (i.e. calls to the receive or fallback methods) require no gas.
 //
 // RequiredGas implements PrecompileContainer.
-func (sc *statefulContainer) RequiredGas(_ []byte) uint64 {
-	return 0
+func (sc *statefulContainer) RequiredGas(input []byte) uint64 {
+	if len(input) < NumBytesMethodID {
+		return 0
+	}
+
+	method, found := sc.idsToMethods[utils.UnsafeBytesToStr(input[:NumBytesMethodID])]
+	if !found {
+		return 0
+	}
+
+	return method.RequiredGas(input)
 }
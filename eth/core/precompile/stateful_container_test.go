@@ -44,9 +44,9 @@ var _ = Describe("Stateful Container", func() {
 	var ctx context.Context
 
 	BeforeEach(func() {
-		sc, err = NewStatefulContainer(&mockStateful{&mockBase{}}, mockIdsToMethods)
+		sc, err = NewStatefulContainer(&mockStateful{&mockBase{}}, mockIdsToMethods, nil, nil)
 		Expect(err).ToNot(HaveOccurred())
-		empty, err = NewStatefulContainer(nil, nil)
+		empty, err = NewStatefulContainer(nil, nil, nil, nil)
 		Expect(empty).To(BeNil())
 		Expect(err).To(MatchError("the stateful precompile has no methods to run"))
 		ctx = vm.NewPolarContext(
@@ -112,6 +112,60 @@ var _ = Describe("Stateful Container", func() {
 			))
 		})
 
+		It("should run the receive and fallback methods", func() {
+			mockWithFallback := &mockStatefulWithFallback{mockStatefulDummy}
+			sc, err = NewStatefulContainer(
+				mockWithFallback,
+				mockIdsToMethods,
+				mockWithFallback.Receive,
+				mockWithFallback.Fallback,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			// receive with value
+			ret, err := sc.Run(ctx, vm.UnwrapPolarContext(ctx).Evm(), blank, common.Address{}, big.NewInt(1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(BeEmpty())
+
+			// receive error
+			_, err = sc.Run(ctx, vm.UnwrapPolarContext(ctx).Evm(), blank, common.Address{}, big.NewInt(0))
+			Expect(err).To(MatchError(vm.ErrExecutionReverted))
+
+			// fallback for short input
+			_, err = sc.Run(ctx, vm.UnwrapPolarContext(ctx).Evm(), []byte{1}, common.Address{}, big.NewInt(0))
+			Expect(err).To(MatchError(vm.ErrExecutionReverted))
+
+			// fallback for unknown method
+			ret, err = sc.Run(ctx, vm.UnwrapPolarContext(ctx).Evm(), badInput, common.Address{}, big.NewInt(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(Equal(badInput))
+
+			// methods are still called
+			var inputs []byte
+			inputs, err = getOutputABI.Inputs.Pack("string")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = sc.Run(
+				ctx,
+				vm.UnwrapPolarContext(ctx).Evm(),
+				append(getOutputABI.ID, inputs...),
+				common.Address{},
+				big.NewInt(0),
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should run the fallback method for empty input without a receive method", func() {
+			mockWithFallback := &mockStatefulWithFallback{mockStatefulDummy}
+			sc, err = NewStatefulContainer(
+				mockWithFallback, mockIdsToMethods, nil, mockWithFallback.Fallback,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			ret, err := sc.Run(ctx, vm.UnwrapPolarContext(ctx).Evm(), blank, common.Address{}, big.NewInt(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(BeEmpty())
+		})
+
 		It("should return properly for valid method calls", func() {
 			var inputs []byte
 			inputs, err = getOutputABI.Inputs.Pack("string")
@@ -21,8 +21,10 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/trie"
@@ -31,6 +33,7 @@ import (
 	"pkg.berachain.dev/polaris/eth/core/precompile"
 	"pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/params"
 	errorslib "pkg.berachain.dev/polaris/lib/errors"
 	"pkg.berachain.dev/polaris/lib/utils"
 )
@@ -46,11 +49,27 @@ type StateProcessor struct {
 
 	// cp provides configuration functions from the underlying chain the EVM is running on.
 	cp ConfigurationPlugin
+	// ac restricts the accounts that can send transactions and create contracts, if the
+	// configuration plugin implements it.
+	ac AccessController
 	// gp provides gas functions from the underlying chain the EVM is running on.
 	gp GasPlugin
 	// pp is responsible for keeping track of the stateful precompile containers that are
 	// available to the EVM and executing them.
 	pp PrecompilePlugin
+	// dp provides the dynamic precompiles that are active at every block, if the precompile
+	// plugin implements it.
+	dp DynamicPrecompileProvider
+
+	// precompiles are the addresses of the precompiles of the host chain registered with the
+	// precompile plugin, at which the marker code is installed in the state.
+	precompiles []common.Address
+	// dynamicPrecompiles are the dynamic precompiles registered with the precompile plugin, indexed
+	// by the address at which they are registered.
+	dynamicPrecompiles map[common.Address]precompile.DynamicImpl
+	// defaultPrecompiles are the addresses of the default geth precompiles registered with the
+	// precompile plugin, which are replaced when the chain config rules change.
+	defaultPrecompiles map[common.Address]struct{}
 
 	// signer is the signer used to verify transaction signatures. We need this in order to to
 	// extract the underlying message from a transaction object in `ProcessTransaction`.
@@ -71,6 +90,13 @@ type StateProcessor struct {
 	sealhash common.Hash // hash of the block prior to being sealed (prior to Finalize called)
 	txs      types.Transactions
 	receipts types.Receipts
+	// hostLogs are the logs that did not originate from the EVM, they are attached to the block
+	// as a synthetic receipt.
+	hostLogs []*types.Log
+
+	// simulation is true iff the processor simulates blocks on top of the state of the chain (e.g.
+	// the pending block), with the precompiles registered by the processor of the chain.
+	simulation bool
 }
 
 // NewStateProcessor creates a new state processor with the given host, statedb, vmConfig, and
@@ -83,23 +109,62 @@ func NewStateProcessor(
 	vmConfig *vm.Config,
 ) *StateProcessor {
 	sp := &StateProcessor{
-		mtx:      sync.Mutex{},
-		cp:       cp,
-		gp:       gp,
-		pp:       pp,
-		vmConfig: vmConfig,
-		statedb:  statedb,
+		mtx:                sync.Mutex{},
+		cp:                 cp,
+		gp:                 gp,
+		pp:                 pp,
+		dynamicPrecompiles: make(map[common.Address]precompile.DynamicImpl),
+		defaultPrecompiles: make(map[common.Address]struct{}),
+		vmConfig:           vmConfig,
+		statedb:            statedb,
+	}
+
+	if ac, ok := cp.(AccessController); ok {
+		sp.ac = ac
 	}
 
 	if sp.pp == nil {
 		sp.pp = precompile.NewDefaultPlugin()
 	} else {
 		sp.BuildAndRegisterPrecompiles(sp.pp.GetPrecompiles(nil))
+		if dp, ok := sp.pp.(DynamicPrecompileProvider); ok {
+			sp.dp = dp
+		}
 	}
 
 	return sp
 }
 
+// NewSimulationProcessor returns a state processor that simulates blocks on top of the given
+// state, with the configuration, access control and precompiles of this processor (e.g. to build
+// the pending block). The simulated blocks consume the gas of the given block gas limit instead of
+// the gas of the host chain, and they use a snapshot of the precompiles registered for the latest
+// block, so that the simulation can run concurrently with this processor.
+func (sp *StateProcessor) NewSimulationProcessor(
+	statedb vm.PolarisStateDB, gasLimit uint64,
+) *StateProcessor {
+	// The precompiles are only changed while a block is processed.
+	sp.mtx.Lock()
+	defer sp.mtx.Unlock()
+
+	return &StateProcessor{
+		cp:          sp.cp,
+		ac:          sp.ac,
+		gp:          &simulationGasPlugin{limit: gasLimit},
+		pp:          sp.newSimulationPrecompilePlugin(),
+		precompiles: append([]common.Address(nil), sp.precompiles...),
+		vmConfig:    sp.vmConfig,
+		statedb:     statedb,
+		simulation:  true,
+	}
+}
+
+// PrecompileManager returns the manager of the precompiles that the EVM given to `Prepare` must
+// run.
+func (sp *StateProcessor) PrecompileManager() vm.PrecompileManager {
+	return sp.pp
+}
+
 // ==============================================================================
 // Block, Tx Lifecycle
 // ==============================================================================
@@ -115,6 +180,7 @@ func (sp *StateProcessor) Prepare(evm *vm.GethEVM, header *types.Header) {
 	sp.sealhash = header.Hash()
 	sp.txs = make(types.Transactions, 0, initialTxsCapacity)
 	sp.receipts = make(types.Receipts, 0, initialTxsCapacity)
+	sp.hostLogs = nil
 
 	// Ensure that the gas plugin and header are in sync.
 	if sp.header.GasLimit != sp.gp.BlockGasLimit() {
@@ -129,10 +195,21 @@ func (sp *StateProcessor) Prepare(evm *vm.GethEVM, header *types.Header) {
 	// Setup the EVM for this block.
 	rules := chainConfig.Rules(sp.header.Number, true, sp.header.Time)
 
-	// We re-register the default geth precompiles every block, this isn't optimal, but since
-	// *technically* the precompiles change based on the chain config rules, to be fully correct,
-	// we should check every block.
-	sp.BuildAndRegisterPrecompiles(precompile.GetDefaultPrecompiles(&rules))
+	// A simulation runs concurrently with the processor of the chain, so it must not change the
+	// precompiles registered with the shared precompile plugin.
+	if !sp.simulation {
+		// We re-register the default geth precompiles every block, this isn't optimal, but since
+		// the precompiles change based on the chain config rules, which may be updated between
+		// blocks (e.g. by a hard fork scheduled by governance), to be fully correct, we should
+		// check every block.
+		sp.registerDefaultPrecompiles(&rules)
+
+		// We also update the dynamic precompiles every block, as they are activated and
+		// deactivated at the block heights scheduled by the host chain (e.g. by governance).
+		if sp.dp != nil {
+			sp.registerDynamicPrecompiles(sp.header.Number.Uint64())
+		}
+	}
 	sp.evm = evm
 }
 
@@ -143,13 +220,30 @@ func (sp *StateProcessor) ProcessTransaction(
 	// We set the gasPool = gasLimit - gasUsed.
 	gasPool := new(GasPool).AddGas(sp.header.GasLimit - sp.gp.BlockGasConsumed())
 
+	// Enforce the access control of the host chain, if any, on the sender of the transaction. The
+	// guard of the state db only stays installed on the EVM for this transaction.
+	var statedb vm.PolarisStateDB = sp.statedb
+	if sp.ac != nil {
+		guard, err := sp.checkAccessControl(tx)
+		if err != nil {
+			return nil, err
+		}
+		if guard != nil {
+			statedb = guard
+			defer func() { sp.evm.StateDB = sp.statedb }()
+		}
+	}
+
 	// Set the transaction context in the state database.
 	// This clears the logs and sets the transaction info.
 	sp.statedb.SetTxContext(tx.Hash(), len(sp.txs))
 
+	// Ensure that the precompiles of the host chain are seen as contracts by the transaction.
+	sp.installPrecompileCode()
+
 	// Inshallah we will be able to apply the transaction.
 	receipt, result, err := ApplyTransactionWithEVMWithResult(
-		sp.evm, sp.cp.ChainConfig(), gasPool, sp.statedb, sp.header.BaseFee,
+		sp.evm, sp.cp.ChainConfig(), gasPool, statedb, sp.header.BaseFee,
 		sp.header.Number, sp.sealhash, sp.header.Time, tx, &sp.header.GasUsed,
 	)
 	if err != nil {
@@ -173,6 +267,13 @@ func (sp *StateProcessor) ProcessTransaction(
 	return result, nil
 }
 
+// AddHostLogs adds the given logs, which did not originate from the EVM, to the block. They are
+// attached to the block as a synthetic receipt, following the receipts of the transactions, when
+// the block is finalized.
+func (sp *StateProcessor) AddHostLogs(logs []*types.Log) {
+	sp.hostLogs = append(sp.hostLogs, logs...)
+}
+
 // Finalize finalizes the block in the state processor and returns the receipts and bloom filter to
 // be "sealed".
 func (sp *StateProcessor) Finalize(
@@ -181,6 +282,18 @@ func (sp *StateProcessor) Finalize(
 	// We unlock the state processor to ensure that the state is consistent.
 	defer sp.mtx.Unlock()
 
+	// Set the state root to the host chain's commitment to the state. Since the host chain commits
+	// its state only after the block is finalized, this is the commitment to the state as of the
+	// end of the parent block.
+	sp.header.Root = sp.statedb.IntermediateRoot(true)
+
+	// Attach the host logs as a synthetic receipt, so that they are part of the bloom of the block.
+	if len(sp.hostLogs) > 0 {
+		sp.receipts = append(sp.receipts, types.NewHostReceipt(
+			sp.header.Number, uint(len(sp.txs)), sp.header.GasUsed, sp.hostLogs,
+		))
+	}
+
 	var (
 		// "FinalizeAndAssemble" the block with the txs and receipts (sets the TxHash, ReceiptHash,
 		// and Bloom).
@@ -239,10 +352,210 @@ func (sp *StateProcessor) BuildAndRegisterPrecompiles(precompiles []precompile.R
 		if err != nil {
 			panic(err)
 		}
-		// TODO: set code on the statedb for every precompiled contract.
 		err = sp.pp.Register(container)
 		if err != nil {
 			panic(err)
 		}
+		sp.precompiles = append(sp.precompiles, pc.RegistryKey())
+	}
+}
+
+// registerDynamicPrecompiles registers the dynamic precompiles that are active at the block of the
+// given number with the precompile plugin, and removes the ones that are no longer active. The
+// marker code installed at the address of a removed precompile is left in the state.
+func (sp *StateProcessor) registerDynamicPrecompiles(number uint64) {
+	active := sp.dp.GetDynamicPrecompiles(sp.statedb.GetContext(), number)
+
+	// remove the dynamic precompiles that were deactivated or replaced
+	for addr, pc := range sp.dynamicPrecompiles {
+		if apc, found := active[addr]; found && apc.Name() == pc.Name() {
+			continue
+		}
+		sp.dp.Remove(addr)
+		delete(sp.dynamicPrecompiles, addr)
+		for i, a := range sp.precompiles {
+			if a == addr {
+				sp.precompiles = append(sp.precompiles[:i], sp.precompiles[i+1:]...)
+				break
+			}
+		}
+	}
+
+	// register the activated dynamic precompiles, in order of address so that the marker code is
+	// installed deterministically
+	addrs := make([]common.Address, 0, len(active))
+	for addr := range active {
+		if _, found := sp.dynamicPrecompiles[addr]; !found && !sp.pp.Has(addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	for _, addr := range addrs {
+		container, err := precompile.NewStatefulFactory().Build(active[addr], sp.pp)
+		if err != nil {
+			panic(err)
+		}
+		if err = sp.pp.Register(&dynamicContainer{container, addr}); err != nil {
+			panic(err)
+		}
+		sp.dynamicPrecompiles[addr] = active[addr]
+		sp.precompiles = append(sp.precompiles, addr)
+	}
+}
+
+// dynamicContainer is the container of a dynamic precompile, which is registered at the address at
+// which the precompile is active, instead of the address of its implementation.
+type dynamicContainer struct {
+	vm.PrecompileContainer
+	address common.Address
+}
+
+// RegistryKey implements `libtypes.Registrable`.
+func (dc *dynamicContainer) RegistryKey() common.Address {
+	return dc.address
+}
+
+// newSimulationPrecompilePlugin returns a precompile plugin which runs a snapshot of the
+// precompiles registered with the precompile plugin of this processor. The processor must be
+// locked, so that the precompiles are not registered while the snapshot is taken.
+func (sp *StateProcessor) newSimulationPrecompilePlugin() *simulationPrecompilePlugin {
+	spp := &simulationPrecompilePlugin{
+		PrecompilePlugin: sp.pp,
+		precompiles: make(
+			map[common.Address]vm.PrecompileContainer,
+			len(sp.precompiles)+len(sp.defaultPrecompiles),
+		),
+	}
+
+	// snapshot the default precompiles in order of address, so that they are active in the same
+	// order in every simulation
+	defaults := make([]common.Address, 0, len(sp.defaultPrecompiles))
+	for addr := range sp.defaultPrecompiles {
+		defaults = append(defaults, addr)
+	}
+	sort.Slice(defaults, func(i, j int) bool {
+		return bytes.Compare(defaults[i][:], defaults[j][:]) < 0
+	})
+	for _, addr := range append(append([]common.Address(nil), sp.precompiles...), defaults...) {
+		if pc := sp.pp.Get(addr); pc != nil {
+			_ = spp.Register(pc)
+		}
+	}
+	return spp
+}
+
+// simulationPrecompilePlugin is the precompile plugin of a simulation processor. It runs the
+// precompiles with the precompile plugin of the chain, but it looks them up in a snapshot of the
+// precompiles registered with it, which the processor of the chain does not change while it
+// registers the precompiles of the next block.
+type simulationPrecompilePlugin struct {
+	PrecompilePlugin
+	// precompiles are the snapshotted precompiles, indexed by the address at which they are
+	// registered.
+	precompiles map[common.Address]vm.PrecompileContainer
+	// active are the addresses of the snapshotted precompiles, in order of registration.
+	active []common.Address
+}
+
+// Has implements `vm.PrecompileManager`.
+func (spp *simulationPrecompilePlugin) Has(addr common.Address) bool {
+	_, found := spp.precompiles[addr]
+	return found
+}
+
+// Get implements `vm.PrecompileManager`.
+func (spp *simulationPrecompilePlugin) Get(addr common.Address) vm.PrecompileContainer {
+	return spp.precompiles[addr]
+}
+
+// GetActive implements `vm.PrecompileManager`.
+func (spp *simulationPrecompilePlugin) GetActive(*params.Rules) []common.Address {
+	return spp.active
+}
+
+// Register registers the given precompile with the snapshot only, the precompile plugin of the
+// chain is not changed.
+//
+// Register implements `precompile.Plugin`.
+func (spp *simulationPrecompilePlugin) Register(pc vm.PrecompileContainer) error {
+	if _, found := spp.precompiles[pc.RegistryKey()]; !found {
+		spp.active = append(spp.active, pc.RegistryKey())
+	}
+	spp.precompiles[pc.RegistryKey()] = pc
+	return nil
+}
+
+// simulationGasPlugin is the gas plugin of a simulation processor, which consumes the gas of the
+// simulated block instead of the gas of the host chain.
+type simulationGasPlugin struct {
+	limit, consumed uint64
+}
+
+// Prepare implements `GasPlugin`.
+func (gp *simulationGasPlugin) Prepare(context.Context) {
+	gp.consumed = 0
+}
+
+// Reset implements `GasPlugin`.
+func (gp *simulationGasPlugin) Reset(context.Context) {}
+
+// ConsumeTxGas implements `GasPlugin`.
+func (gp *simulationGasPlugin) ConsumeTxGas(amount uint64) error {
+	if amount > gp.limit-gp.consumed {
+		gp.consumed = gp.limit
+		return ErrBlockOutOfGas
+	}
+	gp.consumed += amount
+	return nil
+}
+
+// TxGasRemaining implements `GasPlugin`.
+func (gp *simulationGasPlugin) TxGasRemaining() uint64 {
+	return gp.limit - gp.consumed
+}
+
+// BlockGasConsumed implements `GasPlugin`.
+func (gp *simulationGasPlugin) BlockGasConsumed() uint64 {
+	return gp.consumed
+}
+
+// BlockGasLimit implements `GasPlugin`.
+func (gp *simulationGasPlugin) BlockGasLimit() uint64 {
+	return gp.limit
+}
+
+// installPrecompileCode installs the marker code at the address of every precompile of the host
+// chain which does not have it yet. The code is installed lazily, at the start of a transaction,
+// since the state plugin is only able to write to the state of the host chain during one.
+func (sp *StateProcessor) installPrecompileCode() {
+	for _, addr := range sp.precompiles {
+		if sp.statedb.GetCodeHash(addr) == precompile.MarkerCodeHash {
+			continue
+		}
+		if !sp.statedb.Exist(addr) {
+			sp.statedb.CreateAccount(addr)
+		}
+		sp.statedb.SetCode(addr, precompile.MarkerCode)
+	}
+}
+
+// registerDefaultPrecompiles registers the default geth precompiles of the given rules with the
+// precompile plugin, replacing the default precompiles registered for the rules of a previous
+// block. The precompiles of the host chain registered at the default addresses are not replaced.
+func (sp *StateProcessor) registerDefaultPrecompiles(rules *params.Rules) {
+	for _, pc := range precompile.GetDefaultPrecompiles(rules) {
+		addr := pc.RegistryKey()
+		if _, isDefault := sp.defaultPrecompiles[addr]; !isDefault && sp.pp.Has(addr) {
+			continue
+		}
+
+		container, err := precompile.NewStatelessFactory().Build(pc, sp.pp)
+		if err != nil {
+			panic(err)
+		}
+		if err = sp.pp.Register(container); err != nil {
+			panic(err)
+		}
+		sp.defaultPrecompiles[addr] = struct{}{}
 	}
 }
@@ -28,6 +28,7 @@ import (
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	"pkg.berachain.dev/polaris/eth/core/mock"
+	"pkg.berachain.dev/polaris/eth/core/precompile"
 	"pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/eth/core/vm"
 	vmmock "pkg.berachain.dev/polaris/eth/core/vm/mock"
@@ -101,6 +102,17 @@ var _ = Describe("StateProcessor", func() {
 			Expect(receipts).To(BeEmpty())
 			Expect(logs).To(BeEmpty())
 		})
+
+		It("should attach the host logs as a synthetic receipt", func() {
+			sp.AddHostLogs([]*types.Log{{Address: dummyContract}})
+			block, receipts, logs, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(HaveLen(1))
+			Expect(receipts[0].TxHash).To(Equal(types.HostReceiptHash(dummyHeader.Number)))
+			Expect(logs).To(HaveLen(1))
+			Expect(logs[0].BlockHash).To(Equal(block.Hash()))
+			Expect(types.BloomLookup(block.Bloom(), dummyContract)).To(BeTrue())
+		})
 	})
 
 	Context("Block with transactions", func() {
@@ -185,6 +197,379 @@ var _ = Describe("StateProcessor", func() {
 			Expect(logs).To(BeEmpty())
 		})
 	})
+
+	Context("Chain config updates", func() {
+		It("should replace the default precompiles when the hard fork rules change", func() {
+			modExp := common.BytesToAddress([]byte{5})
+			var registered vm.PrecompileContainer
+			pp.RegisterFunc = func(pc vm.PrecompileContainer) error {
+				if pc.RegistryKey() == modExp {
+					registered = pc
+				}
+				return nil
+			}
+
+			// Schedule the Berlin hard fork after the block.
+			_, _, _, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			istanbul := *params.DefaultChainConfig
+			istanbul.BerlinBlock = big.NewInt(2)
+			cp.ChainConfigFunc = func() *params.ChainConfig { return &istanbul }
+			sp.Prepare(evm, dummyHeader)
+			Expect(registered).To(Equal(vm.PrecompiledContractsIstanbul[modExp]))
+
+			// Activate the Berlin hard fork at the block.
+			_, _, _, err = sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			cp.ChainConfigFunc = func() *params.ChainConfig { return params.DefaultChainConfig }
+			sp.Prepare(evm, dummyHeader)
+			Expect(registered).To(Equal(vm.PrecompiledContractsBerlin[modExp]))
+		})
+	})
+})
+
+// hostPrecompile is a stateless precompile of the host chain.
+type hostPrecompile struct{}
+
+func (hostPrecompile) RegistryKey() common.Address {
+	return common.BytesToAddress([]byte{0x69})
+}
+
+func (hostPrecompile) RequiredGas([]byte) uint64 {
+	return 0
+}
+
+func (hostPrecompile) Run(
+	context.Context, vm.PrecompileEVM, []byte, common.Address, *big.Int,
+) ([]byte, error) {
+	return nil, nil
+}
+
+var _ = Describe("Precompile marker code", func() {
+	var (
+		sdb  *vmmock.PolarisStateDBMock
+		sp   *core.StateProcessor
+		code map[common.Address][]byte
+	)
+
+	BeforeEach(func() {
+		sdb = vmmock.NewEmptyStateDB()
+		_, bp, cp, gp, _, pp, _, _ := mock.NewMockHostAndPlugins()
+		bp.GetNewBlockMetadataFunc = func(n uint64) (common.Address, uint64) {
+			return common.BytesToAddress([]byte{2}), uint64(3)
+		}
+		pp.HasFunc = func(addr common.Address) bool {
+			return false
+		}
+		pp.GetPrecompilesFunc = func(_ *params.Rules) []precompile.Registrable {
+			return []precompile.Registrable{hostPrecompile{}}
+		}
+		gp.SetBlockGasLimit(uint64(blockGasLimit))
+		code = make(map[common.Address][]byte)
+		sdb.SetTxContextFunc = func(thash common.Hash, ti int) {}
+		sdb.TxIndexFunc = func() int { return 0 }
+		sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+			return big.NewInt(1000001)
+		}
+		sdb.GetCodeHashFunc = func(addr common.Address) common.Hash {
+			if c, ok := code[addr]; ok {
+				return crypto.Keccak256Hash(c)
+			}
+			return common.Hash{}
+		}
+		sdb.SetCodeFunc = func(addr common.Address, c []byte) {
+			code[addr] = c
+		}
+		sp = core.NewStateProcessor(cp, gp, pp, sdb, &vm.Config{})
+		sp.Prepare(vm.NewGethEVMWithPrecompiles(
+			vm.BlockContext{
+				Transfer:    core.Transfer,
+				CanTransfer: core.CanTransfer,
+			}, vm.TxContext{}, sdb, cp.ChainConfig(), vm.Config{}, pp,
+		), dummyHeader)
+		Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+	})
+
+	It("should install the marker code at the precompiles of the host chain", func() {
+		addr := hostPrecompile{}.RegistryKey()
+		Expect(sdb.SetCodeCalls()).To(BeEmpty())
+
+		_, err := sp.ProcessTransaction(
+			context.Background(), types.MustSignNewTx(key, signer, &types.LegacyTx{
+				To: &dummyContract, Gas: 100000, GasPrice: big.NewInt(1),
+			}),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdb.CreateAccountCalls()).ToNot(BeEmpty())
+		Expect(sdb.CreateAccountCalls()[0].Address).To(Equal(addr))
+		Expect(code).To(HaveKeyWithValue(addr, precompile.MarkerCode))
+
+		// The marker code is only installed once.
+		_, err = sp.ProcessTransaction(
+			context.Background(), types.MustSignNewTx(key, signer, &types.LegacyTx{
+				To: &dummyContract, Gas: 100000, GasPrice: big.NewInt(1),
+			}),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdb.SetCodeCalls()).To(HaveLen(1))
+	})
+})
+
+// dynamicPrecompilePlugin is a precompile plugin that provides the given dynamic precompiles.
+type dynamicPrecompilePlugin struct {
+	*mock.PrecompilePluginMock
+	active  map[common.Address]precompile.DynamicImpl
+	removed []common.Address
+}
+
+func (p *dynamicPrecompilePlugin) GetDynamicPrecompiles(
+	context.Context, uint64,
+) map[common.Address]precompile.DynamicImpl {
+	return p.active
+}
+
+func (p *dynamicPrecompilePlugin) Remove(addr common.Address) {
+	p.removed = append(p.removed, addr)
+}
+
+// dynamicPrecompile is a dynamic precompile without methods.
+type dynamicPrecompile struct {
+	precompile.BaseContract
+}
+
+func (dynamicPrecompile) Name() string {
+	return "dynamic"
+}
+
+var _ = Describe("Dynamic precompiles", func() {
+	var (
+		dp         *dynamicPrecompilePlugin
+		sdb        *vmmock.PolarisStateDBMock
+		sp         *core.StateProcessor
+		registered map[common.Address]vm.PrecompileContainer
+		dynAddr    = common.BytesToAddress([]byte{0x70})
+	)
+
+	BeforeEach(func() {
+		_, bp, cp, gp, _, pp, _, _ := mock.NewMockHostAndPlugins()
+		bp.GetNewBlockMetadataFunc = func(n uint64) (common.Address, uint64) {
+			return common.BytesToAddress([]byte{2}), uint64(3)
+		}
+		registered = make(map[common.Address]vm.PrecompileContainer)
+		pp.HasFunc = func(addr common.Address) bool {
+			_, found := registered[addr]
+			return found
+		}
+		pp.GetFunc = func(addr common.Address) vm.PrecompileContainer {
+			return registered[addr]
+		}
+		pp.RegisterFunc = func(pc vm.PrecompileContainer) error {
+			registered[pc.RegistryKey()] = pc
+			return nil
+		}
+		gp.SetBlockGasLimit(uint64(blockGasLimit))
+		dp = &dynamicPrecompilePlugin{PrecompilePluginMock: pp}
+		sdb = vmmock.NewEmptyStateDB()
+		sdb.GetContextFunc = context.Background
+		sp = core.NewStateProcessor(cp, gp, dp, sdb, &vm.Config{})
+	})
+
+	It("should register and remove the active dynamic precompiles", func() {
+		dp.active = map[common.Address]precompile.DynamicImpl{
+			dynAddr: dynamicPrecompile{precompile.NewBaseContract("[]", dummyContract)},
+		}
+		sp.Prepare(nil, dummyHeader)
+		Expect(registered).To(HaveKey(dynAddr))
+		Expect(registered[dynAddr].RegistryKey()).To(Equal(dynAddr))
+		_, _, _, err := sp.Finalize(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+
+		dp.active = nil
+		sp.Prepare(nil, dummyHeader)
+		Expect(dp.removed).To(Equal([]common.Address{dynAddr}))
+	})
+
+	It("should not change the registered precompiles in a simulation", func() {
+		dp.active = map[common.Address]precompile.DynamicImpl{
+			dynAddr: dynamicPrecompile{precompile.NewBaseContract("[]", dummyContract)},
+		}
+		sp.Prepare(nil, dummyHeader)
+		_, _, _, err := sp.Finalize(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+
+		dp.active = nil
+		simulation := sp.NewSimulationProcessor(sdb, dummyHeader.GasLimit)
+		simulation.Prepare(nil, dummyHeader)
+		Expect(dp.removed).To(BeEmpty())
+		Expect(registered).To(HaveKey(dynAddr))
+		_, _, _, err = simulation.Finalize(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should run a snapshot of the registered precompiles in a simulation", func() {
+		dp.active = map[common.Address]precompile.DynamicImpl{
+			dynAddr: dynamicPrecompile{precompile.NewBaseContract("[]", dummyContract)},
+		}
+		sp.Prepare(nil, dummyHeader)
+		_, _, _, err := sp.Finalize(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		simulation := sp.NewSimulationProcessor(sdb, dummyHeader.GasLimit)
+
+		// the processor of the chain replaces the dynamic precompile at the next block
+		otherAddr := common.BytesToAddress([]byte{0x71})
+		dp.active = map[common.Address]precompile.DynamicImpl{
+			otherAddr: dynamicPrecompile{precompile.NewBaseContract("[]", dummyContract)},
+		}
+		sp.Prepare(nil, dummyHeader)
+		Expect(dp.removed).To(Equal([]common.Address{dynAddr}))
+		Expect(registered).To(HaveKey(otherAddr))
+
+		pm := simulation.PrecompileManager()
+		Expect(pm.Has(dynAddr)).To(BeTrue())
+		Expect(pm.Get(dynAddr).RegistryKey()).To(Equal(dynAddr))
+		Expect(pm.GetActive(nil)).To(ContainElement(dynAddr))
+		Expect(pm.Has(otherAddr)).To(BeFalse())
+		Expect(pm.GetActive(nil)).ToNot(ContainElement(otherAddr))
+	})
+})
+
+// accessControlledConfigPlugin is a configuration plugin that allows only the given account to
+// send transactions or create contracts.
+type accessControlledConfigPlugin struct {
+	*mock.ConfigurationPluginMock
+	sender, deployer common.Address
+}
+
+func (p *accessControlledConfigPlugin) CanSendTransaction(addr common.Address) bool {
+	return addr == p.sender
+}
+
+func (p *accessControlledConfigPlugin) CanCreateContract(addr common.Address) bool {
+	return addr == p.deployer
+}
+
+var _ = Describe("Access control", func() {
+	var (
+		sdb *vmmock.PolarisStateDBMock
+		cp  *accessControlledConfigPlugin
+		gp  *mock.GasPluginMock
+		sp  *core.StateProcessor
+	)
+
+	BeforeEach(func() {
+		var (
+			bp   *mock.BlockPluginMock
+			cpm  *mock.ConfigurationPluginMock
+			pp   *mock.PrecompilePluginMock
+			from = crypto.PubkeyToAddress(key.PublicKey)
+		)
+		sdb = vmmock.NewEmptyStateDB()
+		_, bp, cpm, gp, _, pp, _, _ = mock.NewMockHostAndPlugins()
+		bp.GetNewBlockMetadataFunc = func(n uint64) (common.Address, uint64) {
+			return common.BytesToAddress([]byte{2}), uint64(3)
+		}
+		pp.HasFunc = func(addr common.Address) bool {
+			return false
+		}
+		gp.SetBlockGasLimit(uint64(blockGasLimit))
+		sdb.SetTxContextFunc = func(thash common.Hash, ti int) {}
+		sdb.TxIndexFunc = func() int { return 0 }
+		cp = &accessControlledConfigPlugin{ConfigurationPluginMock: cpm, sender: from}
+		sp = core.NewStateProcessor(cp, gp, pp, sdb, &vm.Config{})
+		sp.Prepare(vm.NewGethEVMWithPrecompiles(
+			vm.BlockContext{
+				Transfer:    core.Transfer,
+				CanTransfer: core.CanTransfer,
+			}, vm.TxContext{}, sdb, cp.ChainConfig(), vm.Config{}, pp,
+		), dummyHeader)
+		Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_, _, _, err := sp.Finalize(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject a transaction of a sender that is not allowed", func() {
+		cp.sender = common.Address{}
+		signedTx := types.MustSignNewTx(key, signer, &types.LegacyTx{
+			To: &dummyContract, Gas: 1000000, GasPrice: big.NewInt(1),
+		})
+		_, err := sp.ProcessTransaction(context.Background(), signedTx)
+		Expect(err).To(MatchError(core.ErrSendNotAllowed))
+	})
+
+	It("should reject a contract creation of a sender that cannot create contracts", func() {
+		signedTx := types.MustSignNewTx(key, signer, &types.LegacyTx{
+			Gas: 1000000, GasPrice: big.NewInt(1), Data: []byte("abcdef"),
+		})
+		_, err := sp.ProcessTransaction(context.Background(), signedTx)
+		Expect(err).To(MatchError(core.ErrCreateNotAllowed))
+	})
+
+	When("the transaction calls a contract that creates a contract", func() {
+		// factory creates an empty contract with CREATE and returns its address.
+		factory := common.Hex2Bytes("600060006000f060005260206000f3")
+
+		BeforeEach(func() {
+			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+				return big.NewInt(1000001)
+			}
+			sdb.GetCodeFunc = func(addr common.Address) []byte {
+				if addr != dummyContract {
+					return nil
+				}
+				return factory
+			}
+			sdb.GetCodeHashFunc = func(addr common.Address) common.Hash {
+				if addr != dummyContract {
+					return common.Hash{}
+				}
+				return crypto.Keccak256Hash(factory)
+			}
+			sdb.ExistFunc = func(addr common.Address) bool {
+				return addr == dummyContract
+			}
+			sdb.FinaliseFunc = func(bool) {}
+		})
+
+		call := func() *core.ExecutionResult {
+			signedTx := types.MustSignNewTx(key, signer, &types.LegacyTx{
+				To: &dummyContract, Gas: 1000000, GasPrice: big.NewInt(1),
+			})
+			result, err := sp.ProcessTransaction(context.Background(), signedTx)
+			Expect(err).ToNot(HaveOccurred())
+			return result
+		}
+
+		It("should fail the creation of a sender that is not allowed to create contracts", func() {
+			result := call()
+			// The transaction is included and pays for its gas, but the creation fails.
+			Expect(result.Err).ToNot(HaveOccurred())
+			Expect(result.UsedGas).To(BeNumerically(">", params.TxGas))
+			Expect(common.BytesToAddress(result.ReturnData)).To(Equal(common.Address{}))
+			// Only the nonce of the sender is incremented by the state transition.
+			Expect(sdb.SetNonceCalls()).To(HaveLen(1))
+			Expect(sdb.SetNonceCalls()[0].Address).To(Equal(crypto.PubkeyToAddress(key.PublicKey)))
+		})
+
+		It("should not keep the guard installed after the transaction", func() {
+			Expect(common.BytesToAddress(call().ReturnData)).To(Equal(common.Address{}))
+
+			cp.deployer = crypto.PubkeyToAddress(key.PublicKey)
+			Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+			result := call()
+			Expect(result.Err).ToNot(HaveOccurred())
+			Expect(common.BytesToAddress(result.ReturnData)).ToNot(Equal(common.Address{}))
+		})
+
+		It("should create the contract of a sender that is allowed to create contracts", func() {
+			cp.deployer = crypto.PubkeyToAddress(key.PublicKey)
+			result := call()
+			Expect(result.Err).ToNot(HaveOccurred())
+			Expect(common.BytesToAddress(result.ReturnData)).ToNot(Equal(common.Address{}))
+		})
+	})
 })
 
 var _ = Describe("No precompile plugin provided", func() {
@@ -88,3 +88,16 @@ type Plugin interface {
 	// function.
 	ForEachStorage(common.Address, func(common.Hash, common.Hash) bool) error
 }
+
+// ProvablePlugin is an OPTIONAL extension of the `Plugin` that the host chain can implement in order
+// to expose its commitment to the state, along with Merkle proofs of the accounts and storage
+// slots against that commitment.
+type ProvablePlugin interface {
+	Plugin
+	// StateRoot returns the host chain's commitment to the state.
+	StateRoot() common.Hash
+	// GetProof returns the Merkle proof of the account at the given address.
+	GetProof(common.Address) ([][]byte, error)
+	// GetStorageProof returns the Merkle proof of the given storage slot of the given account.
+	GetStorageProof(common.Address, common.Hash) ([][]byte, error)
+}
@@ -24,6 +24,7 @@ import (
 	"math/big"
 
 	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/precompile"
 	"pkg.berachain.dev/polaris/eth/crypto"
 	"pkg.berachain.dev/polaris/lib/ds"
 	"pkg.berachain.dev/polaris/lib/ds/stack"
@@ -93,9 +94,9 @@ func (s *suicides) Suicide(addr common.Address) bool {
 		return false
 	}
 
-	// only smart contracts can commit suicide
+	// only smart contracts can commit suicide, which precompiles are not
 	ch := s.ssp.GetCodeHash(addr)
-	if (ch == common.Hash{}) || ch == emptyCodeHash {
+	if (ch == common.Hash{}) || ch == emptyCodeHash || ch == precompile.MarkerCodeHash {
 		return false
 	}
 
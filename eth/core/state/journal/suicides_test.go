@@ -22,6 +22,7 @@ package journal
 
 import (
 	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/precompile"
 	"pkg.berachain.dev/polaris/eth/core/state/journal/mock"
 	"pkg.berachain.dev/polaris/lib/utils"
 
@@ -74,6 +75,18 @@ var _ = Describe("Suicides", func() {
 		Expect(s.HasSuicided(a1)).To(BeFalse())
 	})
 
+	It("should not suicide a precompile", func() {
+		ssp := mock.NewSuicidesStatePluginMock()
+		ssp.GetCodeHashFunc = func(common.Address) common.Hash {
+			return precompile.MarkerCodeHash
+		}
+		s = utils.MustGetAs[*suicides](NewSuicides(ssp))
+
+		s.Snapshot()
+		Expect(s.Suicide(a1)).To(BeFalse())
+		Expect(s.HasSuicided(a1)).To(BeFalse())
+	})
+
 	It("should clone correctly", func() {
 		s.Snapshot()
 		Expect(s.Suicide(a1)).To(BeTrue())
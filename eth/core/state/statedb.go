@@ -104,9 +104,11 @@ func (sdb *stateDB) Finalise(bool) {
 	sdb.ctrl.Finalize()
 }
 
+// Commit finalizes the statedb and returns the host chain's commitment to the state, if the
+// plugin supports it.
 func (sdb *stateDB) Commit(deleteEmptyObjects bool) (common.Hash, error) {
 	sdb.Finalise(deleteEmptyObjects)
-	return common.Hash{}, nil
+	return sdb.IntermediateRoot(deleteEmptyObjects), nil
 }
 
 // =============================================================================
@@ -170,6 +172,11 @@ func (sdb *stateDB) GetCodeSize(addr common.Address) int {
 // Other
 // =============================================================================
 
+// GetPlugin returns the state plugin of the host chain that the statedb is built over.
+func (sdb *stateDB) GetPlugin() Plugin {
+	return sdb.Plugin
+}
+
 // Copy returns a new statedb with cloned plugin and journals.
 func (sdb *stateDB) Copy() StateDBI {
 	return newStateDBWithJournals(
@@ -202,7 +209,12 @@ func (sdb *stateDB) StartPrefetcher(_ string) {}
 
 func (sdb *stateDB) StopPrefetcher() {}
 
+// IntermediateRoot returns the host chain's commitment to the state if the plugin implements
+// `ProvablePlugin`, otherwise it returns the empty hash.
 func (sdb *stateDB) IntermediateRoot(_ bool) common.Hash {
+	if pp, ok := sdb.Plugin.(ProvablePlugin); ok {
+		return pp.StateRoot()
+	}
 	return common.Hash{}
 }
 
@@ -210,12 +222,22 @@ func (sdb *stateDB) StorageTrie(_ common.Address) (Trie, error) {
 	return nil, nil
 }
 
-func (sdb *stateDB) GetStorageProof(_ common.Address, _ common.Hash) ([][]byte, error) {
-	return nil, nil
+// GetStorageProof returns the Merkle proof of the given storage slot if the plugin implements
+// `ProvablePlugin`.
+func (sdb *stateDB) GetStorageProof(addr common.Address, slot common.Hash) ([][]byte, error) {
+	if pp, ok := sdb.Plugin.(ProvablePlugin); ok {
+		return pp.GetStorageProof(addr, slot)
+	}
+	return nil, ErrProofsNotSupported
 }
 
-func (sdb *stateDB) GetProof(_ common.Address) ([][]byte, error) {
-	return nil, nil
+// GetProof returns the Merkle proof of the given account if the plugin implements
+// `ProvablePlugin`.
+func (sdb *stateDB) GetProof(addr common.Address) ([][]byte, error) {
+	if pp, ok := sdb.Plugin.(ProvablePlugin); ok {
+		return pp.GetProof(addr)
+	}
+	return nil, ErrProofsNotSupported
 }
 
 func (sdb *stateDB) GetOrNewStateObject(_ common.Address) *StateObject {
@@ -117,6 +117,14 @@ var _ = Describe("StateDB", func() {
 		Expect(sdb.HasSuicided(bob)).To(BeFalse())
 	})
 
+	It("should not support proofs if the plugin is not provable", func() {
+		Expect(sdb.IntermediateRoot(true)).To(Equal(common.Hash{}))
+		_, err := sdb.GetProof(alice)
+		Expect(err).To(MatchError(state.ErrProofsNotSupported))
+		_, err = sdb.GetStorageProof(alice, slot)
+		Expect(err).To(MatchError(state.ErrProofsNotSupported))
+	})
+
 	It("should handle saved errors", func() {
 		sp.ErrorFunc = func() error {
 			return errors.New("mocked saved error")
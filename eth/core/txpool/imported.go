@@ -22,9 +22,19 @@ package txpool
 
 import "github.com/ethereum/go-ethereum/core/txpool"
 
-type TxPool = txpool.TxPool
+type (
+	TxPool = txpool.TxPool
+	Config = txpool.Config
+)
 
 var (
 	NewTxPool     = txpool.NewTxPool
 	DefaultConfig = txpool.DefaultConfig
+
+	// ErrUnderpriced is returned if a transaction's gas price is below the minimum configured
+	// for the transaction pool.
+	ErrUnderpriced = txpool.ErrUnderpriced
+	// ErrTxPoolOverflow is returned if the transaction pool is full and can't accept another
+	// remote transaction.
+	ErrTxPoolOverflow = txpool.ErrTxPoolOverflow
 )
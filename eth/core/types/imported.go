@@ -52,6 +52,7 @@ var (
 	MakeSigner             = types.MakeSigner
 	CopyHeader             = types.CopyHeader
 	LogsBloom              = types.LogsBloom
+	BloomLookup            = types.BloomLookup
 	LegacyTxType           = types.LegacyTxType
 	DynamicFeeTxType       = types.DynamicFeeTxType
 	AccessListTxType       = types.AccessListTxType
@@ -71,9 +72,15 @@ var (
 	NewBlock               = types.NewBlock
 	NewBlockWithHeader     = types.NewBlockWithHeader
 	ErrInvalidSig          = types.ErrInvalidSig
+
+	NewTransactionsByPriceAndNonce = types.NewTransactionsByPriceAndNonce
 )
 
 var (
 	ReceiptStatusFailed     = types.ReceiptStatusFailed
 	ReceiptStatusSuccessful = types.ReceiptStatusSuccessful
 )
+
+const (
+	BloomBitLength = types.BloomBitLength
+)
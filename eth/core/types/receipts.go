@@ -21,11 +21,19 @@
 package types
 
 import (
+	"math/big"
 	"unsafe"
 
 	"github.com/ethereum/go-ethereum/rlp"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/crypto"
 )
 
+// hostReceiptPrefix is the prefix of the preimage of the hash that identifies the host receipt of
+// a block.
+const hostReceiptPrefix = "polaris-host-receipt"
+
 // MarshalReceipts marshals `Receipts`, as type `[]*ReceiptForStorage`, to bytes using rlp
 // encoding.
 func MarshalReceipts(receipts Receipts) ([]byte, error) {
@@ -49,3 +57,59 @@ func UnmarshalReceipts(bz []byte) (Receipts, error) {
 	//#nosec:G103 unsafe pointer is safe here since `ReceiptForStorage` is an alias of `Receipt`.
 	return *(*Receipts)(unsafe.Pointer(&receiptsForStorage)), nil
 }
+
+// HostReceiptHash returns the hash that identifies the host receipt of the block with the given
+// number, it is used in place of a transaction hash. As there is no transaction with this hash,
+// it has no transaction lookup entry: looking up the transaction or receipt by this hash returns
+// not found, while the host receipt is served with the receipts of its block and its logs by the
+// log filters.
+func HostReceiptHash(number *big.Int) common.Hash {
+	return crypto.Keccak256Hash([]byte(hostReceiptPrefix), number.Bytes())
+}
+
+// NewHostReceipt returns the synthetic receipt that attaches the given logs, which did not
+// originate from the EVM, to the block with the given number. The host receipt follows the
+// receipts of the transactions of the block, so its index is the number of transactions in the
+// block.
+func NewHostReceipt(number *big.Int, index uint, cumulativeGasUsed uint64, logs []*Log) *Receipt {
+	receipt := &Receipt{
+		Type:              uint8(LegacyTxType),
+		Status:            ReceiptStatusSuccessful,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Logs:              logs,
+		TxHash:            HostReceiptHash(number),
+		BlockNumber:       new(big.Int).Set(number),
+		TransactionIndex:  index,
+	}
+	for _, log := range logs {
+		log.TxHash = receipt.TxHash
+		log.TxIndex = index
+		log.BlockNumber = number.Uint64()
+	}
+	receipt.Bloom = CreateBloom(Receipts{receipt})
+	return receipt
+}
+
+// DeriveHostReceiptFields fills in the fields of the given host receipt that are not stored, given
+// the hash and number of its block and the receipts of the transactions of the block.
+func DeriveHostReceiptFields(
+	receipt *Receipt, hash common.Hash, number *big.Int, txReceipts Receipts,
+) {
+	var logIndex uint
+	for _, txReceipt := range txReceipts {
+		logIndex += uint(len(txReceipt.Logs))
+	}
+
+	receipt.TxHash = HostReceiptHash(number)
+	receipt.BlockHash = hash
+	receipt.BlockNumber = new(big.Int).Set(number)
+	receipt.TransactionIndex = uint(len(txReceipts))
+	for _, log := range receipt.Logs {
+		log.TxHash = receipt.TxHash
+		log.TxIndex = receipt.TransactionIndex
+		log.BlockHash = hash
+		log.BlockNumber = number.Uint64()
+		log.Index = logIndex
+		logIndex++
+	}
+}
@@ -34,9 +34,11 @@ type (
 	GethEVM             = vm.EVM
 	GethStateDB         = vm.StateDB
 	GetHashFunc         = vm.GetHashFunc
+	OpCode              = vm.OpCode
 	PrecompileContainer = vm.PrecompiledContract
 	PrecompileManager   = vm.PrecompileManager
 	PrecompileEVM       = vm.PrecompileEVM
+	ScopeContext        = vm.ScopeContext
 	TransferFunc        = vm.TransferFunc
 	TxContext           = vm.TxContext
 )
@@ -51,3 +53,8 @@ var (
 	PrecompiledContractsHomestead = vm.PrecompiledContractsHomestead
 	PrecompiledContractsIstanbul  = vm.PrecompiledContractsIstanbul
 )
+
+const (
+	CREATE  = vm.CREATE
+	CREATE2 = vm.CREATE2
+)
@@ -103,6 +103,9 @@ func NewEmptyStateDB() *PolarisStateDBMock {
 		HasSuicidedFunc: func(address common.Address) bool {
 			return false
 		},
+		IntermediateRootFunc: func(deleteEmptyObjects bool) common.Hash {
+			return common.Hash{}
+		},
 		PrepareFunc: func(rules params.Rules, sender common.Address,
 			coinbase common.Address, dest *common.Address,
 			precompiles []common.Address, txAccesses types.AccessList,
@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package miner
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/event"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/log"
+	"pkg.berachain.dev/polaris/eth/params"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// chainEventBuffer is the size of the buffered channels that receive the chain events.
+const chainEventBuffer = 10
+
+var (
+	// errNoCurrentBlock is returned when the chain does not have a block to build on top of yet.
+	errNoCurrentBlock = errors.New("current block not found")
+	// errNoPendingState is returned when the state of the chain cannot be used to execute
+	// transactions.
+	errNoPendingState = errors.New("state does not support pending block simulation")
+)
+
+// Chain defines the methods of the blockchain that the miner uses to simulate the pending block.
+type Chain interface {
+	// Config returns the chain config of the chain.
+	Config() *params.ChainConfig
+
+	// CurrentBlock returns the header of the latest block of the chain.
+	CurrentBlock() *types.Header
+
+	// GetPoolContent returns the pending and queued txs in the mempool.
+	GetPoolContent() (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
+
+	// StateAtBlockNumber returns a statedb of the state of the chain at the given block number.
+	StateAtBlockNumber(uint64) (vm.GethStateDB, error)
+
+	// GetVMConfig returns the vm.Config of the chain.
+	GetVMConfig() *vm.Config
+
+	// NewEVMBlockContext returns the block context of the EVM for the given header.
+	NewEVMBlockContext(*types.Header) *vm.BlockContext
+
+	// NewSimulationProcessor returns a state processor that simulates blocks with the given gas
+	// limit on top of the given state.
+	NewSimulationProcessor(vm.PolarisStateDB, uint64) *core.StateProcessor
+
+	// SubscribeChainHeadEvent registers a subscription of ChainHeadEvent.
+	SubscribeChainHeadEvent(chan<- core.ChainHeadEvent) event.Subscription
+
+	// SubscribeNewTxsEvent registers a subscription of NewTxsEvent.
+	SubscribeNewTxsEvent(chan<- core.NewTxsEvent) event.Subscription
+}
+
+// Miner simulates the pending block of the chain. Every time a block is finalized or new
+// transactions enter the pending set of the mempool, the miner executes the pending transactions
+// of the mempool, ordered by price and nonce, on top of a cached copy of the latest state. The
+// result is exposed as the pending block, along with its receipts, state and logs.
+type Miner struct {
+	chain Chain
+
+	// mu protects the pending block, receipts and state.
+	mu              sync.RWMutex
+	pendingBlock    *types.Block
+	pendingReceipts types.Receipts
+	pendingState    vm.PolarisStateDB
+
+	// pendingLogsFeed sends the logs of every newly simulated pending block.
+	scope           event.SubscriptionScope
+	pendingLogsFeed event.Feed
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	exitCh    chan struct{}
+	logger    log.Logger
+}
+
+// New returns a new miner that simulates the pending block on top of the given chain.
+func New(chain Chain) *Miner {
+	return &Miner{
+		chain:  chain,
+		exitCh: make(chan struct{}),
+		logger: log.Root(),
+	}
+}
+
+// Start starts simulating the pending block in the background.
+func (m *Miner) Start() {
+	m.startOnce.Do(func() {
+		go m.loop()
+	})
+}
+
+// Stop stops simulating the pending block and closes all the pending logs subscriptions.
+func (m *Miner) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.exitCh)
+		m.scope.Close()
+	})
+}
+
+// PendingBlock returns the pending block, or nil if no pending block has been simulated yet.
+func (m *Miner) PendingBlock() *types.Block {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.pendingBlock
+}
+
+// PendingBlockAndReceipts returns the pending block and its receipts, or nil if no pending block
+// has been simulated yet.
+func (m *Miner) PendingBlockAndReceipts() (*types.Block, types.Receipts) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.pendingBlock, m.pendingReceipts
+}
+
+// Pending returns the pending block and a copy of the state after executing it, or nil if no
+// pending block has been simulated yet.
+func (m *Miner) Pending() (*types.Block, vm.GethStateDB) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.pendingBlock == nil {
+		return nil, nil
+	}
+	return m.pendingBlock, m.pendingState.Copy()
+}
+
+// SubscribePendingLogs registers a subscription of the logs of the pending block, which are sent
+// every time a new pending block is simulated.
+func (m *Miner) SubscribePendingLogs(ch chan<- []*types.Log) event.Subscription {
+	return m.scope.Track(m.pendingLogsFeed.Subscribe(ch))
+}
+
+// loop simulates a new pending block every time a block is finalized or new transactions enter
+// the pending set of the mempool.
+func (m *Miner) loop() {
+	heads := make(chan core.ChainHeadEvent, chainEventBuffer)
+	headSub := m.chain.SubscribeChainHeadEvent(heads)
+	defer headSub.Unsubscribe()
+
+	txs := make(chan core.NewTxsEvent, chainEventBuffer)
+	txsSub := m.chain.SubscribeNewTxsEvent(txs)
+	defer txsSub.Unsubscribe()
+
+	m.update()
+	for {
+		select {
+		case <-heads:
+		case <-txs:
+		case <-headSub.Err():
+			return
+		case <-txsSub.Err():
+			return
+		case <-m.exitCh:
+			return
+		}
+
+		// Coalesce the events that were received while the previous pending block was simulated.
+		for drained := false; !drained; {
+			select {
+			case <-heads:
+			case <-txs:
+			default:
+				drained = true
+			}
+		}
+		m.update()
+	}
+}
+
+// update simulates a new pending block and, if successful, replaces the current one with it.
+func (m *Miner) update() {
+	block, receipts, statedb, err := m.simulate()
+	if errors.Is(err, errNoCurrentBlock) {
+		// The chain has not finalized its first block yet, which will trigger a new update.
+		return
+	} else if err != nil {
+		m.logger.Error("failed to simulate pending block", "err", err)
+		return
+	}
+
+	m.mu.Lock()
+	m.pendingBlock, m.pendingReceipts, m.pendingState = block, receipts, statedb
+	m.mu.Unlock()
+
+	var logs []*types.Log
+	for _, receipt := range receipts {
+		logs = append(logs, receipt.Logs...)
+	}
+	if len(logs) > 0 {
+		m.pendingLogsFeed.Send(logs)
+	}
+}
+
+// simulate builds the pending block on top of the latest block of the chain by processing the
+// pending transactions of the mempool, ordered by price and nonce, with a simulation processor of
+// the chain, which enforces its access control and runs its precompiles. A transaction that fails
+// to apply is skipped, along with the remaining transactions of its sender.
+func (m *Miner) simulate() (*types.Block, types.Receipts, vm.PolarisStateDB, error) {
+	parent := m.chain.CurrentBlock()
+	if parent == nil {
+		return nil, nil, nil, errNoCurrentBlock
+	}
+
+	state, err := m.chain.StateAtBlockNumber(parent.Number.Uint64())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	statedb, ok := utils.GetAs[vm.PolarisStateDB](state)
+	if !ok {
+		return nil, nil, nil, errNoPendingState
+	}
+
+	// The coinbase and time of the next block are only known by the host chain once the block is
+	// prepared, so the pending block inherits the coinbase of its parent and uses the current time.
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Coinbase:   parent.Coinbase,
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		GasLimit:   parent.GasLimit,
+		Time:       uint64(time.Now().Unix()),
+		BaseFee:    misc.CalcBaseFee(m.chain.Config(), parent),
+	}
+	if header.Time <= parent.Time {
+		header.Time = parent.Time + 1
+	}
+
+	ctx := context.Background()
+	processor := m.chain.NewSimulationProcessor(statedb, header.GasLimit)
+	processor.Prepare(
+		vm.NewGethEVMWithPrecompiles(
+			*m.chain.NewEVMBlockContext(header), vm.TxContext{}, statedb, m.chain.Config(),
+			*m.chain.GetVMConfig(), processor.PrecompileManager(),
+		), header,
+	)
+
+	pending, _ := m.chain.GetPoolContent()
+	ordered := types.NewTransactionsByPriceAndNonce(
+		types.MakeSigner(m.chain.Config(), header.Number, header.Time), pending, header.BaseFee,
+	)
+	for tx := ordered.Peek(); tx != nil; tx = ordered.Peek() {
+		// Stop once there is not enough gas left in the block for any transaction.
+		if header.GasLimit-header.GasUsed < params.TxGas {
+			break
+		}
+
+		// The state changes of a transaction that fails to apply are not part of the block.
+		snapshot, gasUsed := statedb.Snapshot(), header.GasUsed
+		if _, err = processor.ProcessTransaction(ctx, tx); err != nil {
+			m.logger.Debug("skipping pending transaction", "tx_hash", tx.Hash(), "err", err)
+			statedb.RevertToSnapshot(snapshot)
+			header.GasUsed = gasUsed
+			ordered.Pop()
+			continue
+		}
+		ordered.Shift()
+	}
+
+	block, receipts, _, err := processor.Finalize(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return block, receipts, statedb, nil
+}
@@ -0,0 +1,367 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package miner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"runtime"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/event"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/mock"
+	"pkg.berachain.dev/polaris/eth/core/state"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	vmmock "pkg.berachain.dev/polaris/eth/core/vm/mock"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMiner(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "eth/miner")
+}
+
+var (
+	key, _        = crypto.GenerateEthKey()
+	otherKey, _   = crypto.GenerateEthKey()
+	signer        = types.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
+	dummyContract = common.HexToAddress("0x9fd0aA3B78277a1E717de9D3de434D4b812e5499")
+	dummyLog      = &types.Log{Address: dummyContract}
+)
+
+var _ = Describe("Miner", func() {
+	var (
+		chain *mockChain
+		m     *Miner
+		tx    *types.Transaction
+	)
+
+	BeforeEach(func() {
+		chain = newMockChain()
+		m = New(chain)
+		tx = signTx(key, 0)
+	})
+
+	It("should not simulate a pending block before the first block", func() {
+		chain.header = nil
+		m.update()
+		Expect(m.PendingBlock()).To(BeNil())
+		block, state := m.Pending()
+		Expect(block).To(BeNil())
+		Expect(state).To(BeNil())
+	})
+
+	It("should simulate an empty pending block on top of the latest block", func() {
+		m.update()
+		block, receipts := m.PendingBlockAndReceipts()
+		Expect(block).ToNot(BeNil())
+		Expect(block.NumberU64()).To(Equal(chain.header.Number.Uint64() + 1))
+		Expect(block.ParentHash()).To(Equal(chain.header.Hash()))
+		Expect(block.Time()).To(BeNumerically(">", chain.header.Time))
+		Expect(block.Transactions()).To(BeEmpty())
+		Expect(receipts).To(BeEmpty())
+	})
+
+	It("should execute the pending transactions of the mempool", func() {
+		chain.pending = map[common.Address]types.Transactions{
+			crypto.PubkeyToAddress(key.PublicKey):      {tx},
+			crypto.PubkeyToAddress(otherKey.PublicKey): {signTx(otherKey, 5)},
+		}
+		m.update()
+
+		block, receipts := m.PendingBlockAndReceipts()
+		Expect(block.Transactions()).To(HaveLen(1))
+		Expect(block.Transactions()[0].Hash()).To(Equal(tx.Hash()))
+		Expect(block.GasUsed()).To(Equal(params.TxGas))
+		Expect(receipts).To(HaveLen(1))
+		Expect(receipts[0].TxHash).To(Equal(tx.Hash()))
+		Expect(receipts[0].BlockHash).To(Equal(block.Hash()))
+		Expect(chain.sdb.RevertToSnapshotCalls()).To(HaveLen(1))
+	})
+
+	It("should enforce the access control of the chain", func() {
+		chain.cp = &allowlistConfigPlugin{
+			ConfigurationPlugin: chain.cp, allowed: crypto.PubkeyToAddress(otherKey.PublicKey),
+		}
+		chain.pending = map[common.Address]types.Transactions{
+			crypto.PubkeyToAddress(key.PublicKey): {tx},
+		}
+		m.update()
+
+		block, receipts := m.PendingBlockAndReceipts()
+		Expect(block.Transactions()).To(BeEmpty())
+		Expect(receipts).To(BeEmpty())
+	})
+
+	It("should return a copy of the pending state", func() {
+		m.update()
+		block, state := m.Pending()
+		Expect(block).To(Equal(m.PendingBlock()))
+		Expect(state).To(Equal(chain.sdb))
+		Expect(chain.sdb.CopyCalls()).To(HaveLen(1))
+	})
+
+	It("should send the logs of the pending block to subscribers", func() {
+		chain.pending = map[common.Address]types.Transactions{
+			crypto.PubkeyToAddress(key.PublicKey): {tx},
+		}
+		chain.sdb.GetLogsFunc = func(common.Hash, uint64, common.Hash) []*types.Log {
+			return []*types.Log{dummyLog}
+		}
+		logs := make(chan []*types.Log, 1)
+		sub := m.SubscribePendingLogs(logs)
+		defer sub.Unsubscribe()
+
+		m.update()
+		Expect(<-logs).To(Equal([]*types.Log{dummyLog}))
+		Expect(dummyLog.BlockHash).To(Equal(m.PendingBlock().Hash()))
+	})
+
+	It("should simulate the pending block concurrently with the processor of the chain", func() {
+		// the processor of the chain does not share any synchronized mock with the simulation
+		gp := mock.NewGasPluginMock()
+		gp.SetBlockGasLimit(chain.header.GasLimit)
+		Expect(gp.SetTxGasLimit(chain.header.GasLimit)).To(Succeed())
+		sdb := vmmock.NewEmptyStateDB()
+		sdb.GetBalanceFunc = func(common.Address) *big.Int { return big.NewInt(1e18) }
+		sdb.TxIndexFunc = func() int { return 0 }
+		chain.processor = core.NewStateProcessor(
+			staticConfigPlugin{}, gp, &registryPrecompilePlugin{
+				PrecompilePluginMock: mock.NewPrecompilePluginMock(),
+				precompiles:          make(map[common.Address]vm.PrecompileContainer),
+			}, sdb, &vm.Config{},
+		)
+		chain.pending = map[common.Address]types.Transactions{
+			crypto.PubkeyToAddress(key.PublicKey): {tx},
+		}
+
+		// run the simulation in parallel with the processor of the chain, even on a single CPU,
+		// so that the race detector observes their accesses to the precompiles
+		defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			for i := 0; i < 10; i++ {
+				m.update()
+			}
+		}()
+
+		// the processor of the chain registers the default precompiles at every block
+		ctx := context.Background()
+		for simulating := true; simulating; {
+			select {
+			case <-done:
+				simulating = false
+			default:
+			}
+			header := types.CopyHeader(chain.header)
+			chain.processor.Prepare(vm.NewGethEVMWithPrecompiles(
+				*chain.NewEVMBlockContext(header), vm.TxContext{}, sdb, chain.Config(),
+				vm.Config{}, chain.processor.PrecompileManager(),
+			), header)
+			_, err := chain.processor.ProcessTransaction(ctx, tx)
+			Expect(err).ToNot(HaveOccurred())
+			_, _, _, err = chain.processor.Finalize(ctx)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(m.PendingBlock().Transactions()).To(HaveLen(1))
+	})
+
+	It("should simulate a new pending block on every new block", func() {
+		m.Start()
+		defer m.Stop()
+		Eventually(m.PendingBlock).ShouldNot(BeNil())
+
+		chain.header = &types.Header{
+			Number:   big.NewInt(2),
+			BaseFee:  big.NewInt(1),
+			GasLimit: chain.header.GasLimit,
+		}
+		chain.headFeed.Send(core.ChainHeadEvent{})
+		Eventually(func() uint64 {
+			return m.PendingBlock().NumberU64()
+		}).Should(Equal(uint64(3)))
+	})
+})
+
+func signTx(key *ecdsa.PrivateKey, nonce uint64) *types.Transaction {
+	return types.MustSignNewTx(key, signer, &types.LegacyTx{
+		Nonce:    nonce,
+		To:       &dummyContract,
+		Gas:      params.TxGas,
+		GasPrice: big.NewInt(1),
+	})
+}
+
+// mockChain is a chain with a single block and a mocked state, on top of which the miner simulates
+// the pending block.
+type mockChain struct {
+	header  *types.Header
+	pending map[common.Address]types.Transactions
+	sdb     *vmmock.PolarisStateDBMock
+	cp      core.ConfigurationPlugin
+	gp      *mock.GasPluginMock
+	pp      *mock.PrecompilePluginMock
+	// processor is the processor of the chain, if it processes blocks.
+	processor *core.StateProcessor
+	headFeed  event.Feed
+	txsFeed   event.Feed
+}
+
+func newMockChain() *mockChain {
+	_, _, cp, gp, _, pp, _, _ := mock.NewMockHostAndPlugins()
+	pp.HasFunc = func(common.Address) bool { return false }
+	sdb := vmmock.NewEmptyStateDB()
+	sdb.GetBalanceFunc = func(common.Address) *big.Int { return big.NewInt(1e18) }
+	sdb.TxIndexFunc = func() int { return 0 }
+	sdb.CopyFunc = func() state.StateDBI { return sdb }
+	return &mockChain{
+		header:  &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1), GasLimit: 1e7},
+		pending: map[common.Address]types.Transactions{},
+		sdb:     sdb,
+		cp:      cp,
+		gp:      gp,
+		pp:      pp,
+	}
+}
+
+func (c *mockChain) Config() *params.ChainConfig {
+	return params.DefaultChainConfig
+}
+
+func (c *mockChain) CurrentBlock() *types.Header {
+	return c.header
+}
+
+func (c *mockChain) GetPoolContent() (
+	map[common.Address]types.Transactions, map[common.Address]types.Transactions,
+) {
+	// the miner consumes the transactions of the content
+	pending := make(map[common.Address]types.Transactions, len(c.pending))
+	for addr, txs := range c.pending {
+		pending[addr] = txs
+	}
+	return pending, nil
+}
+
+func (c *mockChain) StateAtBlockNumber(uint64) (vm.GethStateDB, error) {
+	return c.sdb, nil
+}
+
+func (c *mockChain) GetVMConfig() *vm.Config {
+	return &vm.Config{}
+}
+
+func (c *mockChain) NewEVMBlockContext(header *types.Header) *vm.BlockContext {
+	return &vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		Coinbase:    header.Coinbase,
+		BlockNumber: header.Number,
+		Time:        header.Time,
+		Difficulty:  new(big.Int),
+		BaseFee:     header.BaseFee,
+		GasLimit:    header.GasLimit,
+	}
+}
+
+func (c *mockChain) NewSimulationProcessor(
+	sdb vm.PolarisStateDB, gasLimit uint64,
+) *core.StateProcessor {
+	processor := c.processor
+	if processor == nil {
+		processor = core.NewStateProcessor(c.cp, c.gp, c.pp, c.sdb, &vm.Config{})
+	}
+	return processor.NewSimulationProcessor(sdb, gasLimit)
+}
+
+func (c *mockChain) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
+	return c.headFeed.Subscribe(ch)
+}
+
+func (c *mockChain) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
+	return c.txsFeed.Subscribe(ch)
+}
+
+// staticConfigPlugin is a configuration plugin with the default chain config.
+type staticConfigPlugin struct{}
+
+func (staticConfigPlugin) Prepare(context.Context) {}
+
+func (staticConfigPlugin) ChainConfig() *params.ChainConfig {
+	return params.DefaultChainConfig
+}
+
+// registryPrecompilePlugin is a precompile plugin which registers the precompiles in a map that is
+// not safe for concurrent use.
+type registryPrecompilePlugin struct {
+	*mock.PrecompilePluginMock
+	precompiles map[common.Address]vm.PrecompileContainer
+}
+
+func (p *registryPrecompilePlugin) Has(addr common.Address) bool {
+	_, found := p.precompiles[addr]
+	return found
+}
+
+func (p *registryPrecompilePlugin) Get(addr common.Address) vm.PrecompileContainer {
+	return p.precompiles[addr]
+}
+
+func (p *registryPrecompilePlugin) GetActive(*params.Rules) []common.Address {
+	active := make([]common.Address, 0, len(p.precompiles))
+	for addr := range p.precompiles {
+		active = append(active, addr)
+	}
+	return active
+}
+
+func (p *registryPrecompilePlugin) Register(pc vm.PrecompileContainer) error {
+	p.precompiles[pc.RegistryKey()] = pc
+	return nil
+}
+
+// allowlistConfigPlugin is a configuration plugin that only allows the given account to send
+// transactions.
+type allowlistConfigPlugin struct {
+	core.ConfigurationPlugin
+	allowed common.Address
+}
+
+func (p *allowlistConfigPlugin) CanSendTransaction(addr common.Address) bool {
+	return addr == p.allowed
+}
+
+func (p *allowlistConfigPlugin) CanCreateContract(addr common.Address) bool {
+	return addr == p.allowed
+}
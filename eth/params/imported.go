@@ -38,4 +38,6 @@ var (
 	VersionWithCommit = params.VersionWithCommit
 	// InitialBaseFee is the initial base fee for the first block of the chain.
 	InitialBaseFee = params.InitialBaseFee
+	// TxGas is the gas charged per transaction that is not creating a contract.
+	TxGas = params.TxGas
 )
@@ -21,15 +21,21 @@
 package polarapi
 
 import (
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/ethapi"
 )
 
 type (
-	EthBackend = ethapi.Backend
+	EthBackend      = ethapi.Backend
+	TracerBackend   = tracers.Backend
+	AccountResult   = ethapi.AccountResult
+	StorageResult   = ethapi.StorageResult
+	TransactionArgs = ethapi.TransactionArgs
 )
 
 var (
 	GethAPIs          = ethapi.GetAPIs
+	TracerAPIs        = tracers.APIs
 	NewEthereumAPI    = ethapi.NewEthereumAPI
 	NewBlockChainAPI  = ethapi.NewBlockChainAPI
 	NewTransactionAPI = ethapi.NewTransactionAPI
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polarapi
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/rpc"
+)
+
+// ProofBackend is the collection of methods required to satisfy the proof
+// RPC API.
+type ProofBackend interface {
+	StateAndHeaderByNumberOrHash(
+		context.Context, rpc.BlockNumberOrHash,
+	) (vm.GethStateDB, *types.Header, error)
+}
+
+// ProofAPI is the collection of proof RPC API methods.
+type ProofAPI interface {
+	GetProof(
+		ctx context.Context, address common.Address, storageKeys []string,
+		blockNrOrHash rpc.BlockNumberOrHash,
+	) (*AccountResult, error)
+}
+
+// proofAPI offers the `eth_getProof` RPC method, backed by the proofs of the host chain's state
+// commitment rather than by an Ethereum state trie.
+type proofAPI struct {
+	b ProofBackend
+}
+
+// NewProofAPI creates a new proof API instance.
+func NewProofAPI(b ProofBackend) ProofAPI {
+	return &proofAPI{b}
+}
+
+// GetProof returns the account and storage values of the specified account including the Merkle
+// proofs. The account proof contains the proofs of the balance, code hash and nonce of the account,
+// and each storage proof contains the proof of the storage slot. Proofs are encoded by the host chain,
+// and prove the state after the given block against the state root of the following block.
+func (api *proofAPI) GetProof(
+	ctx context.Context, address common.Address, storageKeys []string,
+	blockNrOrHash rpc.BlockNumberOrHash,
+) (*AccountResult, error) {
+	var (
+		keys         = make([]common.Hash, len(storageKeys))
+		keyLengths   = make([]int, len(storageKeys))
+		storageProof = make([]StorageResult, len(storageKeys))
+	)
+
+	// Deserialize all keys. This prevents state access on invalid input.
+	for i, hexKey := range storageKeys {
+		var err error
+		keys[i], keyLengths[i], err = decodeHash(hexKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	state, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+
+	// Create the proofs for the storage keys.
+	for i, key := range keys {
+		// Output key encoding is a bit special: if the input was a 32-byte hash, it is returned as
+		// such. Otherwise, we apply the QUANTITY encoding mandated by the JSON-RPC spec for
+		// getProof, to match go-ethereum.
+		var outputKey string
+		if keyLengths[i] != common.HashLength {
+			outputKey = hexutil.EncodeBig(key.Big())
+		} else {
+			outputKey = hexutil.Encode(key[:])
+		}
+
+		proof, err := state.GetStorageProof(address, key)
+		if err != nil {
+			return nil, err
+		}
+		storageProof[i] = StorageResult{
+			Key:   outputKey,
+			Value: (*hexutil.Big)(state.GetState(address, key).Big()),
+			Proof: toHexSlice(proof),
+		}
+	}
+
+	// Create the account proof.
+	accountProof, err := state.GetProof(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountResult{
+		Address:      address,
+		AccountProof: toHexSlice(accountProof),
+		Balance:      (*hexutil.Big)(state.GetBalance(address)),
+		CodeHash:     state.GetCodeHash(address),
+		Nonce:        hexutil.Uint64(state.GetNonce(address)),
+		// Polaris does not maintain a storage trie per account, storage slots are proven directly
+		// against the host chain's state commitment.
+		StorageHash:  common.Hash{},
+		StorageProof: storageProof,
+	}, state.Error()
+}
+
+// decodeHash parses a hex-encoded 32-byte hash. The input may optionally be prefixed by 0x and can
+// have a byte length up to 32.
+func decodeHash(s string) (common.Hash, int, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	if (len(s) & 1) > 0 {
+		s = "0" + s
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return common.Hash{}, 0, errors.New("hex string invalid")
+	}
+	if len(b) > common.HashLength {
+		return common.Hash{}, len(b), errors.New("hex string too long, want at most 32 bytes")
+	}
+	return common.BytesToHash(b), len(b), nil
+}
+
+// toHexSlice creates a slice of hex-strings based on []byte.
+func toHexSlice(b [][]byte) []string {
+	r := make([]string, len(b))
+	for i := range b {
+		r[i] = hexutil.Encode(b[i])
+	}
+	return r
+}
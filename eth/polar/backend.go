@@ -30,7 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core/bloombits"
-	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -38,6 +38,7 @@ import (
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/common/hexutil"
 	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/state"
 	"pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/eth/core/vm"
 	"pkg.berachain.dev/polaris/eth/log"
@@ -51,6 +52,7 @@ import (
 // go-ethereum backend object.
 type Backend interface {
 	polarapi.EthBackend
+	polarapi.TracerBackend
 	polarapi.NetBackend
 	polarapi.Web3Backend
 }
@@ -60,7 +62,7 @@ type backend struct {
 	extRPCEnabled bool
 	polar         *Polaris
 	cfg           *Config
-	gpo           *gasprice.Oracle
+	gpo           *gasPriceOracle
 	logger        log.Logger
 }
 
@@ -84,7 +86,7 @@ func NewBackend(
 	if cfg.GPO.Default == nil {
 		panic("cfg.GPO.Default is nil")
 	}
-	b.gpo = gasprice.NewOracle(b, *cfg.GPO)
+	b.gpo = newGasPriceOracle(polar.blockchain, *cfg.GPO)
 	return b
 }
 
@@ -108,23 +110,27 @@ func (b *backend) CurrentBlock() *types.Header {
 	return b.polar.blockchain.CurrentHeader()
 }
 
-// SyncProgress returns the current progress of the sync algorithm.
+// SyncProgress returns the current progress of the host chain node catching up with the network.
+// If the host chain did not provide a status provider, the node is reported as synced.
 func (b *backend) SyncProgress() ethereum.SyncProgress {
-	// Consider implementing this in the future.
-	b.logger.Warn("called eth.rpc.backend.SyncProgress", "sync_progress", "not implemented")
-	return ethereum.SyncProgress{
-		CurrentBlock: 0,
-		HighestBlock: 0,
+	if b.polar.statusProvider == nil {
+		b.logger.Debug("called eth.rpc.backend.SyncProgress", "status_provider", "nil")
+		return ethereum.SyncProgress{}
 	}
+	progress := b.polar.statusProvider.SyncProgress()
+	b.logger.Debug("called eth.rpc.backend.SyncProgress", "current_block", progress.CurrentBlock,
+		"highest_block", progress.HighestBlock)
+	return progress
 }
 
-// SuggestGasTipCap returns the recommended gas tip cap for a new transaction.
+// SuggestGasTipCap returns the recommended gas tip cap for a new transaction, based on the tips
+// paid in the recently finalized blocks and on the current pressure on the transaction pool.
 func (b *backend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
 	defer b.logger.Debug("called eth.rpc.backend.SuggestGasTipCap", "suggested_tip_cap")
 	return b.gpo.SuggestTipCap(ctx)
 }
 
-// FeeHistory returns the base fee and gas used history of the last N blocks.
+// FeeHistory returns the base fee, gas used and reward history of the last N blocks.
 func (b *backend) FeeHistory(ctx context.Context, blockCount uint64, lastBlock rpc.BlockNumber,
 	rewardPercentiles []float64) (*big.Int, [][]*big.Int, []*big.Int, []float64, error) {
 	return b.gpo.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
@@ -182,12 +188,12 @@ func (b *backend) SetHead(_ uint64) {
 func (b *backend) HeaderByNumber(_ context.Context, number rpc.BlockNumber) (*types.Header, error) {
 	switch number {
 	case rpc.PendingBlockNumber:
-		// TODO: handle "miner" stuff, Pending block is only known by the miner
-		// block := b.eth.miner.PendingBlock()
-		// TODO: this may be hiding a larger issue with the timing of the NewHead channel stuff.
-		// Investigate and hopefully remove this GTE.
-		header := b.polar.blockchain.CurrentHeader()
-		return header, nil
+		// Pending block is only known by the miner, fallback to the latest block if the miner
+		// has not simulated it yet.
+		if block := b.polar.miner.PendingBlock(); block != nil {
+			return block.Header(), nil
+		}
+		return b.polar.blockchain.CurrentHeader(), nil
 	case rpc.LatestBlockNumber:
 		return b.polar.blockchain.CurrentHeader(), nil
 	case rpc.FinalizedBlockNumber:
@@ -229,12 +235,13 @@ func (b *backend) HeaderByHash(_ context.Context, hash common.Hash) (*types.Head
 
 // BlockByNumber returns the block with the given `number`.
 func (b *backend) BlockByNumber(_ context.Context, number rpc.BlockNumber) (*types.Block, error) {
-	// Pending block is only known by the miner
 	switch number {
 	case rpc.PendingBlockNumber:
-		// 	block := b.eth.miner.PendingBlock()
-		// 	return block, nil
-		//  TODO: handling pending in the miner.
+		// Pending block is only known by the miner, fallback to the latest block if the miner
+		// has not simulated it yet.
+		if block := b.polar.miner.PendingBlock(); block != nil {
+			return block, nil
+		}
 		header := b.polar.blockchain.CurrentBlock()
 		return b.polar.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
 
@@ -255,7 +262,11 @@ func (b *backend) BlockByNumber(_ context.Context, number rpc.BlockNumber) (*typ
 		return b.polar.blockchain.GetBlockByNumber(0), nil
 	}
 	// safe to assume number > 0
-	return b.polar.blockchain.GetBlockByNumber(uint64(number)), nil
+	block := b.polar.blockchain.GetBlockByNumber(uint64(number))
+	if block == nil && uint64(number) < b.polar.blockchain.EarliestBlockNumber() {
+		return nil, core.ErrHistoryPruned
+	}
+	return block, nil
 }
 
 // BlockByHash returns the block with the given `hash`.
@@ -264,6 +275,9 @@ func (b *backend) BlockByHash(_ context.Context, hash common.Hash) (*types.Block
 	b.logger.Debug("BlockByHash", "hash", hash, "block", block)
 	if block == nil {
 		b.logger.Error("eth.rpc.backend.BlockByHash", "hash", hash, "nil", true)
+		if b.polar.blockchain.IsHistoryPruned(hash) {
+			return nil, core.ErrHistoryPruned
+		}
 		return nil, nil //nolint:nilnil // to match geth.
 	}
 	b.logger.Debug("called eth.rpc.backend.BlockByHash", "header", block.Header(),
@@ -278,6 +292,9 @@ func (b *backend) BlockByNumberOrHash(ctx context.Context, blockNrOrHash rpc.Blo
 	if hash, ok := blockNrOrHash.Hash(); ok {
 		block := b.polar.blockchain.GetBlockByHash(hash)
 		if block == nil {
+			if b.polar.blockchain.IsHistoryPruned(hash) {
+				return nil, core.ErrHistoryPruned
+			}
 			return nil, core.ErrBlockNotFound
 		}
 		// if blockNrOrHash.RequireCanonical && b.polar.blockchain.GetCanonicalHash(header.Number.Uint64()) != hash {
@@ -295,12 +312,12 @@ func (b *backend) BlockByNumberOrHash(ctx context.Context, blockNrOrHash rpc.Blo
 func (b *backend) StateAndHeaderByNumber(
 	ctx context.Context, number rpc.BlockNumber,
 ) (vm.GethStateDB, *types.Header, error) {
-	// TODO: handling pending better
-	// // Pending state is only known by the miner
-	// if number == rpc.PendingBlockNumber {
-	// 	block, state := b.eth.miner.Pending()
-	// 	return state, block.Header(), nil
-	// }
+	// Pending state is only known by the miner
+	if number == rpc.PendingBlockNumber {
+		if block, state := b.polar.miner.Pending(); block != nil {
+			return state, block.Header(), nil
+		}
+	}
 
 	// Otherwise resolve the block number and return its state
 	header, err := b.HeaderByNumber(ctx, number)
@@ -355,15 +372,18 @@ func (b *backend) GetTransaction(
 	b.logger.Debug("called eth.rpc.backend.GetTransaction", "tx_hash", txHash)
 	txLookup := b.polar.blockchain.GetTransactionLookup(txHash)
 	if txLookup == nil {
+		if b.polar.blockchain.IsHistoryPruned(txHash) {
+			return nil, common.Hash{}, 0, 0, core.ErrHistoryPruned
+		}
 		return nil, common.Hash{}, 0, 0, nil
 	}
 	return txLookup.Tx, txLookup.BlockHash, txLookup.BlockNum, txLookup.TxIndex, nil
 }
 
-// PendingBlockAndReceipts returns the pending block (equivalent to current block in Polaris)
-// and associated receipts.
+// PendingBlockAndReceipts returns the pending block simulated by the miner and associated
+// receipts.
 func (b *backend) PendingBlockAndReceipts() (*types.Block, types.Receipts) {
-	block, receipts := b.polar.blockchain.PendingBlockAndReceipts()
+	block, receipts := b.polar.miner.PendingBlockAndReceipts()
 	// If the block is non-existent, return nil.
 	// This is to maintain parity with the behavior of the geth backend.
 	if block == nil {
@@ -377,7 +397,11 @@ func (b *backend) PendingBlockAndReceipts() (*types.Block, types.Receipts) {
 
 // GetReceipts returns the receipts for the given block hash.
 func (b *backend) GetReceipts(_ context.Context, hash common.Hash) (types.Receipts, error) {
-	return b.polar.blockchain.GetReceiptsByHash(hash), nil
+	receipts := b.polar.blockchain.GetReceiptsByHash(hash)
+	if receipts == nil && b.polar.blockchain.IsHistoryPruned(hash) {
+		return nil, core.ErrHistoryPruned
+	}
+	return receipts, nil
 }
 
 // GetLogs returns the logs for the given block hash or number.
@@ -385,6 +409,9 @@ func (b *backend) GetLogs(
 	_ context.Context, blockHash common.Hash, number uint64,
 ) ([][]*types.Log, error) {
 	receipts := b.polar.blockchain.GetReceiptsByHash(blockHash)
+	if receipts == nil && b.polar.blockchain.IsHistoryPruned(blockHash) {
+		return nil, core.ErrHistoryPruned
+	}
 	logs := make([][]*types.Log, len(receipts))
 	for i, receipt := range receipts {
 		logs[i] = receipt.Logs
@@ -437,6 +464,43 @@ func (b *backend) SubscribeChainSideEvent(ch chan<- core.ChainSideEvent) event.S
 	return b.polar.blockchain.SubscribeChainSideEvent(ch)
 }
 
+// ==============================================================================
+// Tracing API
+// ==============================================================================
+
+// StateAtBlock returns the state after the given block has been applied. Polaris reads historical
+// state directly from the host chain instead of regenerating it by re-executing blocks, so the
+// `reexec` limit, the `base` state to re-execute on top of, and `readOnly` and `preferDisk` are
+// ignored. The state of a block that the host chain has pruned cannot be returned.
+func (b *backend) StateAtBlock(
+	_ context.Context, block *types.Block, _ uint64, _ state.StateDBI, _ bool, _ bool,
+) (state.StateDBI, tracers.StateReleaseFunc, error) {
+	statedb, err := b.polar.blockchain.StateAtBlockNumber(block.NumberU64())
+	if err != nil {
+		b.logger.Error("eth.rpc.backend.StateAtBlock", "number", block.NumberU64(), "err", err)
+		return nil, nil, err
+	}
+	b.logger.Debug("called eth.rpc.backend.StateAtBlock", "number", block.NumberU64())
+	return utils.MustGetAs[state.StateDBI](statedb), func() {}, nil
+}
+
+// StateAtTransaction returns the execution environment of the transaction at `txIndex` in the
+// given block, built by replaying the preceding transactions on top of the parent state. The
+// parent state is read directly from the host chain, so the `reexec` limit is ignored.
+func (b *backend) StateAtTransaction(
+	ctx context.Context, block *types.Block, txIndex int, _ uint64,
+) (*core.Message, vm.BlockContext, state.StateDBI, tracers.StateReleaseFunc, error) {
+	msg, blockContext, statedb, err := b.polar.blockchain.StateAtTransaction(ctx, block, txIndex)
+	if err != nil {
+		b.logger.Error("eth.rpc.backend.StateAtTransaction", "number", block.NumberU64(),
+			"tx_index", txIndex, "err", err)
+		return nil, vm.BlockContext{}, nil, nil, err
+	}
+	b.logger.Debug("called eth.rpc.backend.StateAtTransaction", "number", block.NumberU64(),
+		"tx_index", txIndex)
+	return msg, blockContext, utils.MustGetAs[state.StateDBI](statedb), func() {}, nil
+}
+
 // ==============================================================================
 // Transaction Pool API
 // ==============================================================================
@@ -489,7 +553,7 @@ func (b *backend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscri
 }
 
 func (b *backend) Engine() consensus.Engine {
-	return nil
+	return b.polar.blockchain.Engine()
 }
 
 // GetBody retrieves the block body corresponding to block by has or number..
@@ -520,16 +584,21 @@ func (b *backend) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription
 }
 
 func (b *backend) SubscribePendingLogsEvent(ch chan<- []*types.Log) event.Subscription {
-	return b.polar.blockchain.SubscribePendingLogsEvent(ch)
+	return b.polar.miner.SubscribePendingLogs(ch)
 }
 
+// BloomStatus returns the section size of the bloombits index and the number of sections that
+// have been indexed.
 func (b *backend) BloomStatus() (uint64, uint64) {
-	// TODO: Implement your code here
-	return 0, 0
+	size, sections := b.polar.bloomIndexer.Status()
+	b.logger.Debug("called eth.rpc.backend.BloomStatus", "size", size, "sections", sections)
+	return size, sections
 }
 
-func (b *backend) ServiceFilter(_ context.Context, _ *bloombits.MatcherSession) {
-	// TODO: Implement your code here
+// ServiceFilter services the bloombits retrievals of the given matcher session.
+func (b *backend) ServiceFilter(_ context.Context, session *bloombits.MatcherSession) {
+	b.logger.Debug("called eth.rpc.backend.ServiceFilter")
+	b.polar.bloomIndexer.Service(session)
 }
 
 // Version returns the current chain protocol version.
@@ -544,14 +613,22 @@ func (b *backend) Version() string {
 	return chainID.String()
 }
 
+// Listening returns whether the host chain node is listening for p2p connections. If the host
+// chain did not provide a status provider, the node is reported as listening.
 func (b *backend) Listening() bool {
-	// TODO: Implement your code here
-	return true
+	if b.polar.statusProvider == nil {
+		return true
+	}
+	return b.polar.statusProvider.Listening()
 }
 
+// PeerCount returns the number of p2p peers connected to the host chain node. If the host chain
+// did not provide a status provider, a single peer is reported.
 func (b *backend) PeerCount() hexutil.Uint {
-	// TODO: Implement your code here
-	return 1
+	if b.polar.statusProvider == nil {
+		return 1
+	}
+	return hexutil.Uint(b.polar.statusProvider.PeerCount())
 }
 
 // ClientVersion returns the current client version.
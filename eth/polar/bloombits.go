@@ -0,0 +1,327 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/log"
+	"pkg.berachain.dev/polaris/eth/params"
+)
+
+const (
+	// bloomServiceThreads is the number of goroutines used globally by a Polaris instance to
+	// service bloombits lookups for all running filters.
+	bloomServiceThreads = 16
+
+	// bloomFilterThreads is the number of goroutines used locally per filter to multiplex
+	// requests onto the global servicing goroutines.
+	bloomFilterThreads = 3
+
+	// bloomRetrievalBatch is the maximum number of bloom bit retrievals to service in a single
+	// batch.
+	bloomRetrievalBatch = 16
+
+	// bloomRetrievalWait is the maximum time to wait for enough bloom bit requests to
+	// accumulate request an entire batch (avoiding hysteresis).
+	bloomRetrievalWait = time.Duration(0)
+
+	// bloomChainHeadBuffer is the size of the buffered channel that receives chain head events.
+	bloomChainHeadBuffer = 10
+
+	// bloomDatabaseCache and bloomDatabaseHandles are the resources allocated to the bloombits
+	// database when it is opened through the networking stack.
+	bloomDatabaseCache   = 16
+	bloomDatabaseHandles = 16
+
+	// bloomDatabaseName is the name of the dedicated bloombits database.
+	bloomDatabaseName = "bloombits"
+)
+
+var (
+	// bloomSectionsKey is the key under which the number of indexed sections is persisted.
+	bloomSectionsKey = []byte("polaris-bloombits-sections")
+
+	// fullBloom is the bloom with every bit set, which matches every filter.
+	fullBloom = func() types.Bloom {
+		var bloom types.Bloom
+		for i := range bloom {
+			bloom[i] = 0xff
+		}
+		return bloom
+	}()
+)
+
+// databaseOpener is implemented by networking stacks that are able to open a persistent
+// database in their data directory (i.e the go-ethereum node).
+type databaseOpener interface {
+	OpenDatabase(name string, cache, handles int, namespace string, readonly bool) (ethdb.Database, error)
+}
+
+// bloomChain defines the methods of the blockchain that the bloom indexer uses to index the header
+// blooms.
+type bloomChain interface {
+	CurrentHeader() *types.Header
+	GetHeaderByNumber(uint64) *types.Header
+	SubscribeChainHeadEvent(chan<- core.ChainHeadEvent) event.Subscription
+}
+
+// bloomIndexer builds and serves the bloombits index of the chain. The index is built section by
+// section (every `params.BloomBitsBlocks` blocks) from the header blooms and is stored in a
+// dedicated database, so that `eth_getLogs` over large ranges can use the go-ethereum
+// `bloombits.Matcher` instead of walking receipts block by block.
+type bloomIndexer struct {
+	db      ethdb.Database
+	chain   bloomChain
+	size    uint64
+	logger  log.Logger
+	started atomic.Bool
+
+	// quit is closed to stop the goroutines of the indexer, which are tracked by wg.
+	quit      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+
+	// sections is the number of fully indexed sections.
+	sections atomic.Uint64
+
+	// requests is the channel of bloombits retrieval requests of all running filters.
+	requests chan chan *bloombits.Retrieval
+}
+
+// newBloomIndexer opens the bloombits database using the given networking stack, falling back
+// to an in-memory database if the stack cannot open one, and returns a new bloom indexer.
+func newBloomIndexer(chain bloomChain, stack NetworkingStack) (*bloomIndexer, error) {
+	var db ethdb.Database
+	if opener, ok := stack.(databaseOpener); ok {
+		var err error
+		if db, err = opener.OpenDatabase(
+			bloomDatabaseName, bloomDatabaseCache, bloomDatabaseHandles, "polaris/db/bloombits/", false,
+		); err != nil {
+			return nil, err
+		}
+	} else {
+		db = rawdb.NewMemoryDatabase()
+	}
+
+	bi := &bloomIndexer{
+		db:       db,
+		chain:    chain,
+		size:     params.BloomBitsBlocks,
+		logger:   log.Root(),
+		quit:     make(chan struct{}),
+		requests: make(chan chan *bloombits.Retrieval),
+	}
+
+	// Load the number of sections that have already been indexed.
+	if enc, err := db.Get(bloomSectionsKey); err == nil && len(enc) == 8 {
+		bi.sections.Store(binary.BigEndian.Uint64(enc))
+	}
+	return bi, nil
+}
+
+// Start starts the goroutines that index new sections and service bloombits retrievals. It is
+// safe to call multiple times.
+func (bi *bloomIndexer) Start() {
+	select {
+	case <-bi.quit:
+		return
+	default:
+	}
+	if !bi.started.CompareAndSwap(false, true) {
+		return
+	}
+	bi.wg.Add(bloomServiceThreads + 1)
+	for i := 0; i < bloomServiceThreads; i++ {
+		go bi.serve()
+	}
+	go bi.index()
+}
+
+// Close stops the goroutines of the indexer and closes its database. It is safe to call multiple
+// times.
+func (bi *bloomIndexer) Close() error {
+	var err error
+	bi.closeOnce.Do(func() {
+		close(bi.quit)
+		bi.wg.Wait()
+		err = bi.db.Close()
+	})
+	return err
+}
+
+// Status returns the section size and the number of fully indexed sections.
+func (bi *bloomIndexer) Status() (uint64, uint64) {
+	return bi.size, bi.sections.Load()
+}
+
+// Service multiplexes the bloombits retrievals of the given matcher session onto the global
+// servicing goroutines.
+func (bi *bloomIndexer) Service(session *bloombits.MatcherSession) {
+	for i := 0; i < bloomFilterThreads; i++ {
+		go session.Multiplex(bloomRetrievalBatch, bloomRetrievalWait, bi.requests)
+	}
+}
+
+// serve services the bloombits retrieval requests of the running filters.
+func (bi *bloomIndexer) serve() {
+	defer bi.wg.Done()
+	for {
+		var request chan *bloombits.Retrieval
+		select {
+		case request = <-bi.requests:
+		case <-bi.quit:
+			return
+		}
+
+		task := <-request
+		task.Bitsets = make([][]byte, len(task.Sections))
+		for i, section := range task.Sections {
+			head := rawdb.ReadCanonicalHash(bi.db, (section+1)*bi.size-1)
+			compVector, err := rawdb.ReadBloomBits(bi.db, task.Bit, section, head)
+			if err != nil {
+				task.Error = err
+				break
+			}
+			if task.Bitsets[i], err = bitutil.DecompressBytes(compVector, int(bi.size/8)); err != nil {
+				task.Error = err
+				break
+			}
+		}
+		request <- task
+	}
+}
+
+// index indexes all the sections that are complete as of the current head and then keeps
+// indexing new sections as the chain progresses.
+func (bi *bloomIndexer) index() {
+	defer bi.wg.Done()
+	heads := make(chan core.ChainHeadEvent, bloomChainHeadBuffer)
+	sub := bi.chain.SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	if head := bi.chain.CurrentHeader(); head != nil {
+		bi.processUpTo(head.Number.Uint64())
+	}
+	for {
+		select {
+		case ev := <-heads:
+			if ev.Block != nil {
+				bi.processUpTo(ev.Block.NumberU64())
+			}
+		case <-sub.Err():
+			return
+		case <-bi.quit:
+			return
+		}
+	}
+}
+
+// processUpTo indexes every section that is complete as of the given head block number.
+func (bi *bloomIndexer) processUpTo(head uint64) {
+	for section := bi.sections.Load(); (section+1)*bi.size <= head+1; section++ {
+		select {
+		case <-bi.quit:
+			return
+		default:
+		}
+		if err := bi.processSection(section); err != nil {
+			bi.logger.Error("failed to index bloombits section", "section", section, "err", err)
+			return
+		}
+		bi.logger.Debug("indexed bloombits section", "section", section)
+	}
+}
+
+// processSection generates the bloombits of the given section from the header blooms and writes
+// them to the database, along with the section head and the new number of sections.
+//
+// The headers of a section may be missing, i.e. pruned by the retention policy of the historical
+// plugin or never stored by a state-synced node. Since the section must still be indexed for the
+// following sections to be indexed, a missing header is indexed with a full bloom, which makes its
+// block a candidate of every filter; the filter then finds no logs for it.
+func (bi *bloomIndexer) processSection(section uint64) error {
+	gen, err := bloombits.NewGenerator(uint(bi.size))
+	if err != nil {
+		return err
+	}
+
+	var (
+		head    *types.Header
+		missing int
+	)
+	for i := uint64(0); i < bi.size; i++ {
+		bloom := fullBloom
+		if head = bi.chain.GetHeaderByNumber(section*bi.size + i); head != nil {
+			bloom = head.Bloom
+		} else {
+			missing++
+		}
+		if err = gen.AddBloom(uint(i), bloom); err != nil {
+			return err
+		}
+	}
+	if missing > 0 {
+		bi.logger.Warn(
+			"indexed bloombits section with missing headers", "section", section, "missing", missing,
+		)
+	}
+
+	// The section head is only used as part of the database keys of the bloombits of the section,
+	// so an empty hash is used if the header of the last block of the section is missing.
+	var headHash common.Hash
+	if head != nil {
+		headHash = head.Hash()
+	}
+	batch := bi.db.NewBatch()
+	for bit := uint(0); bit < types.BloomBitLength; bit++ {
+		var bits []byte
+		if bits, err = gen.Bitset(bit); err != nil {
+			return err
+		}
+		rawdb.WriteBloomBits(batch, bit, section, headHash, bitutil.CompressBytes(bits))
+	}
+	rawdb.WriteCanonicalHash(batch, headHash, (section+1)*bi.size-1)
+
+	enc := make([]byte, 8) //nolint:gomnd // uint64.
+	binary.BigEndian.PutUint64(enc, section+1)
+	if err = batch.Put(bloomSectionsKey, enc); err != nil {
+		return err
+	}
+	if err = batch.Write(); err != nil {
+		return err
+	}
+
+	bi.sections.Store(section + 1)
+	return nil
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	"github.com/ethereum/go-ethereum/event"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// testSectionSize is the bloombits section size used in the tests.
+const testSectionSize = 8
+
+var _ = Describe("Bloom Indexer", func() {
+	var (
+		chain *mockBloomChain
+		bi    *bloomIndexer
+		addr  = common.HexToAddress("0x1234")
+	)
+
+	BeforeEach(func() {
+		chain = &mockBloomChain{headers: map[uint64]*types.Header{}}
+		for i := uint64(0); i < 2*testSectionSize+3; i++ {
+			chain.addHeader(i, nil)
+		}
+		chain.addHeader(3, []*types.Log{{Address: addr}})
+		chain.addHeader(testSectionSize+5, []*types.Log{{Address: addr}})
+
+		var err error
+		bi, err = newBloomIndexer(chain, nil)
+		Expect(err).ToNot(HaveOccurred())
+		bi.size = testSectionSize
+	})
+
+	AfterEach(func() {
+		Expect(bi.Close()).To(Succeed())
+	})
+
+	// match returns the block numbers of the indexed range that match the address.
+	match := func(end uint64) []uint64 {
+		matcher := bloombits.NewMatcher(testSectionSize, [][][]byte{{addr.Bytes()}})
+		results := make(chan uint64)
+		session, err := matcher.Start(context.Background(), 0, end, results)
+		Expect(err).ToNot(HaveOccurred())
+		defer session.Close()
+		bi.Service(session)
+
+		var numbers []uint64
+		for number := range results {
+			numbers = append(numbers, number)
+		}
+		Expect(session.Error()).ToNot(HaveOccurred())
+		return numbers
+	}
+
+	It("should index a section and serve its bloombits", func() {
+		Expect(bi.processSection(0)).To(Succeed())
+		size, sections := bi.Status()
+		Expect(size).To(Equal(uint64(testSectionSize)))
+		Expect(sections).To(Equal(uint64(1)))
+
+		bi.Start()
+		Expect(match(testSectionSize - 1)).To(Equal([]uint64{3}))
+	})
+
+	It("should only index the sections that are complete", func() {
+		bi.processUpTo(2*testSectionSize - 2)
+		_, sections := bi.Status()
+		Expect(sections).To(Equal(uint64(1)))
+
+		bi.processUpTo(2*testSectionSize + 2)
+		_, sections = bi.Status()
+		Expect(sections).To(Equal(uint64(2)))
+
+		bi.Start()
+		Expect(match(2*testSectionSize - 1)).To(Equal([]uint64{3, testSectionSize + 5}))
+	})
+
+	It("should index the sections with missing headers", func() {
+		delete(chain.headers, 6)
+		delete(chain.headers, 2*testSectionSize-1)
+
+		bi.processUpTo(2*testSectionSize + 2)
+		_, sections := bi.Status()
+		Expect(sections).To(Equal(uint64(2)))
+
+		// The blocks of the missing headers are candidates of every filter.
+		bi.Start()
+		Expect(match(2*testSectionSize - 1)).To(Equal(
+			[]uint64{3, 6, testSectionSize + 5, 2*testSectionSize - 1},
+		))
+	})
+
+	It("should index new sections on new chain heads", func() {
+		chain.current = chain.headers[testSectionSize-1]
+		bi.Start()
+		Eventually(func() uint64 {
+			_, sections := bi.Status()
+			return sections
+		}).Should(Equal(uint64(1)))
+
+		chain.headFeed.Send(core.ChainHeadEvent{
+			Block: types.NewBlockWithHeader(chain.headers[2*testSectionSize+2]),
+		})
+		Eventually(func() uint64 {
+			_, sections := bi.Status()
+			return sections
+		}).Should(Equal(uint64(2)))
+	})
+
+	It("should stop and close the database", func() {
+		bi.Start()
+		Expect(bi.Close()).To(Succeed())
+		Expect(bi.Close()).To(Succeed())
+		_, err := bi.db.Get(bloomSectionsKey)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+// mockBloomChain is a chain of headers for the bloom indexer.
+type mockBloomChain struct {
+	headers  map[uint64]*types.Header
+	current  *types.Header
+	headFeed event.Feed
+}
+
+func (c *mockBloomChain) addHeader(number uint64, logs []*types.Log) {
+	c.headers[number] = &types.Header{
+		Number: new(big.Int).SetUint64(number),
+		Bloom:  types.CreateBloom(types.Receipts{{Logs: logs}}),
+	}
+}
+
+func (c *mockBloomChain) CurrentHeader() *types.Header {
+	return c.current
+}
+
+func (c *mockBloomChain) GetHeaderByNumber(number uint64) *types.Header {
+	return c.headers[number]
+}
+
+func (c *mockBloomChain) SubscribeChainHeadEvent(
+	ch chan<- core.ChainHeadEvent,
+) event.Subscription {
+	return c.headFeed.Subscribe(ch)
+}
@@ -30,6 +30,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
+
+	"pkg.berachain.dev/polaris/eth/core/txpool"
 )
 
 const (
@@ -44,11 +46,15 @@ const (
 func DefaultConfig() *Config {
 	gpoConfig := ethconfig.FullNodeGPO
 	gpoConfig.Default = big.NewInt(gpoDefault)
+	// The minimum tip is not enforced unless one is configured by the operator.
+	txPoolConfig := txpool.DefaultConfig
+	txPoolConfig.PriceLimit = 0
 	return &Config{
 		GPO:           &gpoConfig,
 		RPCGasCap:     ethconfig.Defaults.RPCGasCap,
 		RPCTxFeeCap:   ethconfig.Defaults.RPCTxFeeCap,
 		RPCEVMTimeout: ethconfig.Defaults.RPCEVMTimeout,
+		TxPool:        txPoolConfig,
 	}
 }
 
@@ -66,11 +72,34 @@ type Config struct {
 	// RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for
 	// send-transaction variants. The unit is ether.
 	RPCTxFeeCap float64 `toml:""`
+
+	// TxPool is the transaction pool config, a relative journal path is resolved against the
+	// Polaris data directory.
+	TxPool txpool.Config `toml:""`
+
+	// History is the retention policy of the historical data. It can only prune the historical
+	// data stored in a node-local database, never the consensus store.
+	History HistoryConfig `toml:""`
+}
+
+// HistoryConfig represents the retention policy of the historical blocks, receipts, and
+// transactions of the chain. The zero value keeps all history.
+type HistoryConfig struct {
+	// Blocks is the number of most recent blocks to keep, 0 means no limit.
+	Blocks uint64 `toml:""`
+
+	// Age is the maximum age of the blocks to keep, 0 means no limit.
+	Age time.Duration `toml:""`
+
+	// Tombstones is the number of pruned blocks whose hashes are still reported as pruned, 0
+	// means the default.
+	Tombstones uint64 `toml:""`
 }
 
-// LoadConfigFromFilePath reads in a Polaris config file from the fileystem.
+// LoadConfigFromFilePath reads in a Polaris config file from the fileystem. The txpool settings
+// that are missing from the file are set to their defaults.
 func LoadConfigFromFilePath(filename string) (*Config, error) {
-	var config Config
+	config := Config{TxPool: DefaultConfig().TxPool}
 
 	// Read the TOML file
 	bytes, err := os.ReadFile(filename) //#nosec: G304 // required.
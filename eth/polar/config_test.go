@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"pkg.berachain.dev/polaris/eth/core/txpool"
+)
+
+var _ = Describe("Config", func() {
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "polaris.toml")
+	})
+
+	It("should load the history retention policy", func() {
+		Expect(os.WriteFile(path, []byte(`
+[History]
+Blocks = 1000
+Age = "24h"
+Tombstones = 10
+`), 0o600)).To(Succeed())
+
+		cfg, err := LoadConfigFromFilePath(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.History).To(Equal(HistoryConfig{
+			Blocks:     1000,
+			Age:        24 * time.Hour,
+			Tombstones: 10,
+		}))
+	})
+
+	It("should merge the txpool config over the defaults", func() {
+		Expect(os.WriteFile(path, []byte(`
+[TxPool]
+AccountSlots = 32
+`), 0o600)).To(Succeed())
+
+		cfg, err := LoadConfigFromFilePath(path)
+		Expect(err).ToNot(HaveOccurred())
+		expected := DefaultConfig().TxPool
+		expected.AccountSlots = 32
+		Expect(cfg.TxPool).To(Equal(expected))
+	})
+
+	It("should use the default txpool config without a txpool section", func() {
+		Expect(os.WriteFile(path, []byte("RPCGasCap = 1\n"), 0o600)).To(Succeed())
+
+		cfg, err := LoadConfigFromFilePath(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.TxPool).To(Equal(DefaultConfig().TxPool))
+	})
+
+	It("should not enforce a minimum tip by default", func() {
+		Expect(DefaultConfig().TxPool.PriceLimit).To(BeZero())
+		expected := txpool.DefaultConfig
+		expected.PriceLimit = 0
+		Expect(DefaultConfig().TxPool).To(Equal(expected))
+	})
+
+	It("should keep all history by default", func() {
+		Expect(os.WriteFile(path, []byte("RPCGasCap = 1\n"), 0o600)).To(Succeed())
+
+		cfg, err := LoadConfigFromFilePath(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.History).To(BeZero())
+		Expect(DefaultConfig().History).To(BeZero())
+	})
+})
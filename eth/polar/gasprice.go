@@ -0,0 +1,372 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/log"
+)
+
+const (
+	// tipsCacheSize is the number of blocks whose sorted tips are cached by the gas price oracle.
+	tipsCacheSize = 2048
+
+	// gpoDefaultPercentile is the percentile used when the configured one is invalid.
+	gpoDefaultPercentile = 60
+)
+
+var (
+	// errInvalidPercentile is returned when the reward percentiles of a fee history request are
+	// not monotonically increasing values in [0, 100].
+	errInvalidPercentile = errors.New("invalid reward percentile")
+	// errRequestBeyondHead is returned when the last block of a fee history request is above the
+	// current head of the chain.
+	errRequestBeyondHead = errors.New("request beyond head block")
+)
+
+// blockTip is the effective tip paid by a transaction of a block and the gas it used.
+type blockTip struct {
+	tip     *big.Int
+	gasUsed uint64
+}
+
+// gasPriceOracle recommends gas tips and serves the fee history from the effective tips paid by
+// the transactions of the recently finalized blocks, which are read through the historical
+// plugin, and from the current pressure on the transaction pool.
+type gasPriceOracle struct {
+	chain  core.Blockchain
+	cfg    gasprice.Config
+	logger log.Logger
+
+	// tipsCache caches the effective tips of the transactions of a block, sorted in ascending
+	// order, by block hash.
+	tipsCache *lru.Cache[common.Hash, []blockTip]
+
+	// lastHead and lastTip are the last finalized block that the chain tip was suggested for and
+	// the suggested chain tip, mu protects them.
+	lastHead common.Hash
+	lastTip  *big.Int
+	mu       sync.Mutex
+}
+
+// newGasPriceOracle returns a new gas price oracle that reads the chain through the given
+// blockchain, after sanitizing the given config.
+func newGasPriceOracle(chain core.Blockchain, cfg gasprice.Config) *gasPriceOracle {
+	logger := log.Root()
+	if cfg.Blocks < 1 {
+		logger.Warn("sanitizing invalid gasprice oracle sample blocks", "provided", cfg.Blocks, "updated", 1)
+		cfg.Blocks = 1
+	}
+	if cfg.Percentile < 0 || cfg.Percentile > 100 {
+		logger.Warn("sanitizing invalid gasprice oracle percentile", "provided", cfg.Percentile,
+			"updated", gpoDefaultPercentile)
+		cfg.Percentile = gpoDefaultPercentile
+	}
+	if cfg.MaxPrice == nil || cfg.MaxPrice.Sign() <= 0 {
+		logger.Warn("sanitizing invalid gasprice oracle price cap", "provided", cfg.MaxPrice,
+			"updated", gasprice.DefaultMaxPrice)
+		cfg.MaxPrice = gasprice.DefaultMaxPrice
+	}
+	if cfg.IgnorePrice == nil || cfg.IgnorePrice.Sign() < 0 {
+		logger.Warn("sanitizing invalid gasprice oracle ignore price", "provided", cfg.IgnorePrice,
+			"updated", gasprice.DefaultIgnorePrice)
+		cfg.IgnorePrice = gasprice.DefaultIgnorePrice
+	}
+
+	return &gasPriceOracle{
+		chain:     chain,
+		cfg:       cfg,
+		logger:    logger,
+		tipsCache: lru.NewCache[common.Hash, []blockTip](tipsCacheSize),
+		lastTip:   new(big.Int).Set(cfg.Default),
+	}
+}
+
+// SuggestTipCap returns the recommended gas tip cap for a new transaction. It is the configured
+// percentile of the tips paid in the last `Blocks` finalized blocks, raised to the tip needed to
+// be included in the next block if the pending transactions can fill it, and capped at
+// `MaxPrice`.
+func (o *gasPriceOracle) SuggestTipCap(ctx context.Context) (*big.Int, error) {
+	head := o.chain.CurrentFinalBlock()
+	if head == nil {
+		return new(big.Int).Set(o.cfg.Default), nil
+	}
+
+	tip, err := o.chainTip(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+	if poolTip := o.poolTip(head); poolTip != nil && poolTip.Cmp(tip) > 0 {
+		tip = poolTip
+	}
+	if tip.Cmp(o.cfg.MaxPrice) > 0 {
+		tip = new(big.Int).Set(o.cfg.MaxPrice)
+	}
+	return tip, nil
+}
+
+// chainTip returns the configured percentile of the tips paid in the last `Blocks` blocks up to
+// the given head. If none of these blocks has transactions, the last suggested tip is returned.
+func (o *gasPriceOracle) chainTip(ctx context.Context, head *types.Header) (*big.Int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if head.Hash() == o.lastHead {
+		return new(big.Int).Set(o.lastTip), nil
+	}
+
+	var samples []*big.Int
+	for i, number := 0, head.Number.Int64(); i < o.cfg.Blocks && number >= 0; i, number = i+1, number-1 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		block := o.chain.GetBlockByNumber(uint64(number))
+		if block == nil {
+			// The older blocks have been pruned.
+			break
+		}
+		tips, err := o.blockTips(block)
+		if err != nil {
+			return nil, err
+		}
+		var sampled []*big.Int
+		for _, tip := range tips {
+			if tip.tip.Cmp(o.cfg.IgnorePrice) >= 0 {
+				sampled = append(sampled, tip.tip)
+			}
+		}
+		if len(sampled) > 0 {
+			samples = append(samples, sampled[(len(sampled)-1)*o.cfg.Percentile/100])
+		}
+	}
+
+	if len(samples) > 0 {
+		sort.Slice(samples, func(i, j int) bool { return samples[i].Cmp(samples[j]) < 0 })
+		o.lastTip = samples[(len(samples)-1)*o.cfg.Percentile/100]
+	}
+	o.lastHead = head.Hash()
+	return new(big.Int).Set(o.lastTip), nil
+}
+
+// poolTip returns the tip needed to be included in the block following the given head, if the
+// pending transactions of the transaction pool can fill it, otherwise it returns nil.
+func (o *gasPriceOracle) poolTip(head *types.Header) *big.Int {
+	pending, _ := o.chain.GetPoolContent()
+
+	var (
+		tips     []blockTip
+		totalGas uint64
+	)
+	for _, txs := range pending {
+		for _, tx := range txs {
+			tips = append(tips, blockTip{tip: tx.EffectiveGasTipValue(head.BaseFee), gasUsed: tx.Gas()})
+			totalGas += tx.Gas()
+		}
+	}
+	if totalGas < head.GasLimit {
+		return nil
+	}
+
+	// The highest paying transactions are included first, so the marginal tip is the tip of the
+	// transaction that fills the block.
+	sort.Slice(tips, func(i, j int) bool { return tips[i].tip.Cmp(tips[j].tip) > 0 })
+	var cumulativeGas uint64
+	for _, tip := range tips {
+		if cumulativeGas += tip.gasUsed; cumulativeGas >= head.GasLimit {
+			return tip.tip
+		}
+	}
+	return nil
+}
+
+// FeeHistory returns the base fees, the gas used ratios and the gas weighted reward percentiles
+// of the last `blockCount` blocks up to `lastBlock`, along with the number of the oldest
+// returned block. The base fee of the block following `lastBlock` is also returned.
+func (o *gasPriceOracle) FeeHistory(
+	ctx context.Context, blockCount uint64, lastBlock rpc.BlockNumber, percentiles []float64,
+) (*big.Int, [][]*big.Int, []*big.Int, []float64, error) {
+	if blockCount < 1 {
+		return common.Big0, nil, nil, nil, nil
+	}
+	for i, p := range percentiles {
+		if p < 0 || p > 100 || (i > 0 && p < percentiles[i-1]) {
+			return common.Big0, nil, nil, nil, fmt.Errorf("%w: %f", errInvalidPercentile, p)
+		}
+	}
+	maxHistory := o.cfg.MaxHeaderHistory
+	if len(percentiles) > 0 {
+		maxHistory = o.cfg.MaxBlockHistory
+	}
+	if blockCount > uint64(maxHistory) {
+		o.logger.Warn("sanitizing fee history length", "requested", blockCount, "truncated", maxHistory)
+		blockCount = uint64(maxHistory)
+	}
+
+	last, err := o.resolveBlockNumber(lastBlock)
+	if err != nil {
+		return common.Big0, nil, nil, nil, err
+	}
+	if blockCount > last+1 {
+		blockCount = last + 1
+	}
+	oldest := last + 1 - blockCount
+
+	var (
+		reward       = make([][]*big.Int, 0, blockCount)
+		baseFee      = make([]*big.Int, 0, blockCount+1)
+		gasUsedRatio = make([]float64, 0, blockCount)
+		nextBaseFee  *big.Int
+	)
+	for number := oldest; number <= last; number++ {
+		if err = ctx.Err(); err != nil {
+			return common.Big0, nil, nil, nil, err
+		}
+		block := o.chain.GetBlockByNumber(number)
+		if block == nil {
+			if number == oldest {
+				if number < o.chain.EarliestBlockNumber() {
+					return common.Big0, nil, nil, nil, core.ErrHistoryPruned
+				}
+				return common.Big0, nil, nil, nil, core.ErrBlockNotFound
+			}
+			// Return the available blocks only.
+			break
+		}
+		header := block.Header()
+
+		blockBaseFee := new(big.Int)
+		if header.BaseFee != nil {
+			blockBaseFee.Set(header.BaseFee)
+		}
+		baseFee = append(baseFee, blockBaseFee)
+		if header.GasLimit > 0 {
+			gasUsedRatio = append(gasUsedRatio, float64(header.GasUsed)/float64(header.GasLimit))
+		} else {
+			gasUsedRatio = append(gasUsedRatio, 0)
+		}
+		if len(percentiles) > 0 {
+			tips, tipsErr := o.blockTips(block)
+			if tipsErr != nil {
+				return common.Big0, nil, nil, nil, tipsErr
+			}
+			reward = append(reward, rewards(tips, header.GasUsed, percentiles))
+		}
+
+		nextBaseFee = new(big.Int)
+		if config := o.chain.Config(); config.IsLondon(new(big.Int).Add(header.Number, common.Big1)) {
+			nextBaseFee = misc.CalcBaseFee(config, header)
+		}
+	}
+	baseFee = append(baseFee, nextBaseFee)
+
+	if len(percentiles) == 0 {
+		reward = nil
+	}
+	return new(big.Int).SetUint64(oldest), reward, baseFee, gasUsedRatio, nil
+}
+
+// resolveBlockNumber returns the number of the given, possibly special, block number.
+func (o *gasPriceOracle) resolveBlockNumber(number rpc.BlockNumber) (uint64, error) {
+	head := o.chain.CurrentHeader()
+	switch number {
+	case rpc.PendingBlockNumber, rpc.LatestBlockNumber:
+		return head.Number.Uint64(), nil
+	case rpc.FinalizedBlockNumber, rpc.SafeBlockNumber:
+		final := o.chain.CurrentFinalBlock()
+		if final == nil {
+			return 0, core.ErrBlockNotFound
+		}
+		return final.Number.Uint64(), nil
+	case rpc.EarliestBlockNumber:
+		return 0, nil
+	default:
+		if number < 0 || uint64(number) > head.Number.Uint64() {
+			return 0, fmt.Errorf("%w: requested %d, head %d", errRequestBeyondHead, number, head.Number)
+		}
+		return uint64(number), nil
+	}
+}
+
+// blockTips returns the effective tips paid by the transactions of the given block, sorted in
+// ascending order.
+func (o *gasPriceOracle) blockTips(block *types.Block) ([]blockTip, error) {
+	if tips, ok := o.tipsCache.Get(block.Hash()); ok {
+		return tips, nil
+	}
+
+	txs := block.Transactions()
+	receipts := o.chain.GetReceiptsByHash(block.Hash())
+	// The host receipt of the block, if any, follows the receipts of the transactions.
+	if len(receipts) < len(txs) {
+		return nil, fmt.Errorf(
+			"receipts of block %d are missing: %d < %d",
+			block.NumberU64(), len(receipts), len(txs),
+		)
+	}
+
+	tips := make([]blockTip, len(txs))
+	for i, tx := range txs {
+		tips[i] = blockTip{tip: tx.EffectiveGasTipValue(block.BaseFee()), gasUsed: receipts[i].GasUsed}
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].tip.Cmp(tips[j].tip) < 0 })
+
+	o.tipsCache.Add(block.Hash(), tips)
+	return tips, nil
+}
+
+// rewards returns the gas weighted percentiles of the given sorted tips, that is, for each
+// percentile, the tip of the transaction at which the cumulative gas used reaches the percentile
+// of the gas used by the block.
+func rewards(tips []blockTip, gasUsed uint64, percentiles []float64) []*big.Int {
+	reward := make([]*big.Int, len(percentiles))
+	if len(tips) == 0 {
+		for i := range reward {
+			reward[i] = new(big.Int)
+		}
+		return reward
+	}
+
+	var txIndex int
+	sumGasUsed := tips[0].gasUsed
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(gasUsed) * p / 100) //nolint:gomnd // percentage.
+		for sumGasUsed < thresholdGasUsed && txIndex < len(tips)-1 {
+			txIndex++
+			sumGasUsed += tips[txIndex].gasUsed
+		}
+		reward[i] = new(big.Int).Set(tips[txIndex].tip)
+	}
+	return reward
+}
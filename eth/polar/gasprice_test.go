@@ -0,0 +1,339 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// testGasLimit is the gas limit of the blocks used in the gas price oracle tests.
+	testGasLimit = 100
+	// testBaseFee is the base fee of the blocks used in the gas price oracle tests.
+	testBaseFee = 10
+)
+
+// testTx is a transaction of a test block, with the given effective tip and gas used.
+type testTx struct {
+	tip, gas int64
+}
+
+var _ = Describe("Gas Price Oracle", func() {
+	var (
+		chain *mockGPOChain
+		cfg   gasprice.Config
+	)
+
+	BeforeEach(func() {
+		chain = &mockGPOChain{receipts: map[common.Hash]types.Receipts{}}
+		cfg = gasprice.Config{
+			Blocks:           2,
+			Percentile:       50,
+			MaxHeaderHistory: 10,
+			MaxBlockHistory:  10,
+			Default:          big.NewInt(7),
+			MaxPrice:         big.NewInt(1000),
+			IgnorePrice:      big.NewInt(2),
+		}
+	})
+
+	Describe("SuggestTipCap", func() {
+		It("should suggest the default tip before the first block", func() {
+			tip, err := newGasPriceOracle(chain, cfg).SuggestTipCap(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tip).To(Equal(big.NewInt(7)))
+		})
+
+		DescribeTable("should suggest the percentile of the recent tips",
+			func(blocks [][]testTx, pending []testTx, percentile int, expected int64) {
+				for _, txs := range blocks {
+					chain.addBlock(txs)
+				}
+				chain.addPending(pending)
+				cfg.Percentile = percentile
+
+				tip, err := newGasPriceOracle(chain, cfg).SuggestTipCap(context.Background())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tip).To(Equal(big.NewInt(expected)))
+			},
+			Entry("empty blocks keep the default", [][]testTx{{}, {}}, nil, 50, int64(7)),
+			Entry("single tx", [][]testTx{{}, {{tip: 30, gas: 10}}}, nil, 50, int64(30)),
+			Entry("median of the block medians",
+				[][]testTx{
+					{{tip: 10, gas: 1}, {tip: 20, gas: 1}, {tip: 30, gas: 1}},
+					{{tip: 40, gas: 1}, {tip: 50, gas: 1}, {tip: 60, gas: 1}},
+				}, nil, 50, int64(20)),
+			Entry("0th percentile",
+				[][]testTx{
+					{{tip: 10, gas: 1}, {tip: 20, gas: 1}},
+					{{tip: 40, gas: 1}, {tip: 50, gas: 1}},
+				}, nil, 0, int64(10)),
+			Entry("100th percentile",
+				[][]testTx{
+					{{tip: 10, gas: 1}, {tip: 20, gas: 1}},
+					{{tip: 40, gas: 1}, {tip: 50, gas: 1}},
+				}, nil, 100, int64(50)),
+			Entry("only the last blocks are sampled",
+				[][]testTx{{{tip: 900, gas: 1}}, {{tip: 30, gas: 1}}, {{tip: 40, gas: 1}}},
+				nil, 100, int64(40)),
+			Entry("tips below the ignore price are not sampled",
+				[][]testTx{{{tip: 1, gas: 1}, {tip: 1, gas: 1}, {tip: 30, gas: 1}}}, nil, 0, int64(30)),
+			Entry("capped at the max price", [][]testTx{{{tip: 5000, gas: 1}}}, nil, 50, int64(1000)),
+			Entry("pool tip when the pending txs fill the block",
+				[][]testTx{{{tip: 30, gas: 1}}},
+				[]testTx{{tip: 90, gas: 40}, {tip: 80, gas: 40}, {tip: 70, gas: 40}, {tip: 60, gas: 40}},
+				50, int64(70)),
+			Entry("no pool tip when the pending txs do not fill the block",
+				[][]testTx{{{tip: 30, gas: 1}}}, []testTx{{tip: 90, gas: 40}}, 50, int64(30)),
+			Entry("chain tip when it is above the pool tip",
+				[][]testTx{{{tip: 30, gas: 1}}},
+				[]testTx{{tip: 20, gas: 50}, {tip: 20, gas: 50}}, 50, int64(30)),
+		)
+
+		It("should keep the last suggested tip for empty blocks", func() {
+			chain.addBlock([]testTx{{tip: 30, gas: 1}})
+			oracle := newGasPriceOracle(chain, cfg)
+			tip, err := oracle.SuggestTipCap(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tip).To(Equal(big.NewInt(30)))
+
+			chain.addBlock(nil)
+			chain.addBlock(nil)
+			tip, err = oracle.SuggestTipCap(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tip).To(Equal(big.NewInt(30)))
+		})
+
+		It("should fail if the receipts of a block are missing", func() {
+			block := chain.addBlock([]testTx{{tip: 30, gas: 1}})
+			delete(chain.receipts, block.Hash())
+			_, err := newGasPriceOracle(chain, cfg).SuggestTipCap(context.Background())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("FeeHistory", func() {
+		BeforeEach(func() {
+			chain.addBlock(nil)
+			chain.addBlock([]testTx{{tip: 30, gas: 60}, {tip: 10, gas: 10}, {tip: 20, gas: 30}})
+			chain.addBlock([]testTx{{tip: 50, gas: 50}})
+		})
+
+		DescribeTable("should return the gas weighted reward percentiles",
+			func(percentiles []float64, expected [][]int64) {
+				oldest, reward, _, _, err := newGasPriceOracle(chain, cfg).FeeHistory(
+					context.Background(), 3, rpc.LatestBlockNumber, percentiles,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(oldest).To(Equal(big.NewInt(0)))
+				Expect(reward).To(HaveLen(len(expected)))
+				for i, blockReward := range reward {
+					Expect(blockReward).To(HaveLen(len(expected[i])))
+					for j, r := range blockReward {
+						Expect(r).To(Equal(big.NewInt(expected[i][j])), "block %d, percentile %d", i, j)
+					}
+				}
+			},
+			Entry("no percentiles", nil, [][]int64{}),
+			Entry("0th and 100th percentiles", []float64{0, 100},
+				[][]int64{{0, 0}, {10, 30}, {50, 50}}),
+			Entry("percentiles at the gas boundaries", []float64{10, 11, 40, 41},
+				[][]int64{{0, 0, 0, 0}, {10, 20, 20, 30}, {50, 50, 50, 50}}),
+		)
+
+		DescribeTable("should reject invalid percentiles",
+			func(percentiles []float64) {
+				_, _, _, _, err := newGasPriceOracle(chain, cfg).FeeHistory(
+					context.Background(), 1, rpc.LatestBlockNumber, percentiles,
+				)
+				Expect(err).To(MatchError(errInvalidPercentile))
+			},
+			Entry("negative", []float64{-1}),
+			Entry("above 100", []float64{101}),
+			Entry("decreasing", []float64{50, 40}),
+		)
+
+		It("should return the base fees and gas used ratios", func() {
+			oldest, reward, baseFees, ratios, err := newGasPriceOracle(chain, cfg).FeeHistory(
+				context.Background(), 2, rpc.BlockNumber(2), nil,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(oldest).To(Equal(big.NewInt(1)))
+			Expect(reward).To(BeNil())
+			Expect(ratios).To(Equal([]float64{1, 0.5}))
+			Expect(baseFees).To(HaveLen(3))
+			Expect(baseFees[0]).To(Equal(big.NewInt(testBaseFee)))
+			Expect(baseFees[1]).To(Equal(big.NewInt(testBaseFee)))
+			Expect(baseFees[2]).To(Equal(chain.nextBaseFee()))
+		})
+
+		It("should truncate the requested number of blocks", func() {
+			oldest, _, baseFees, ratios, err := newGasPriceOracle(chain, cfg).FeeHistory(
+				context.Background(), 100, rpc.LatestBlockNumber, nil,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(oldest).To(Equal(big.NewInt(0)))
+			Expect(ratios).To(HaveLen(3))
+			Expect(baseFees).To(HaveLen(4))
+		})
+
+		It("should return nothing for no blocks", func() {
+			oldest, reward, baseFees, ratios, err := newGasPriceOracle(chain, cfg).FeeHistory(
+				context.Background(), 0, rpc.LatestBlockNumber, []float64{50},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(oldest).To(Equal(common.Big0))
+			Expect(reward).To(BeNil())
+			Expect(baseFees).To(BeNil())
+			Expect(ratios).To(BeNil())
+		})
+
+		It("should reject requests beyond the head", func() {
+			_, _, _, _, err := newGasPriceOracle(chain, cfg).FeeHistory(
+				context.Background(), 1, rpc.BlockNumber(3), nil,
+			)
+			Expect(err).To(MatchError(errRequestBeyondHead))
+		})
+
+		It("should fail for pruned and missing blocks", func() {
+			chain.blocks[0] = nil
+			_, _, _, _, err := newGasPriceOracle(chain, cfg).FeeHistory(
+				context.Background(), 3, rpc.LatestBlockNumber, nil,
+			)
+			Expect(err).To(MatchError(core.ErrBlockNotFound))
+
+			chain.earliest = 1
+			_, _, _, _, err = newGasPriceOracle(chain, cfg).FeeHistory(
+				context.Background(), 3, rpc.LatestBlockNumber, nil,
+			)
+			Expect(err).To(MatchError(core.ErrHistoryPruned))
+		})
+	})
+})
+
+// mockGPOChain is a chain of test blocks, with a transaction pool, that is read by the gas price
+// oracle.
+type mockGPOChain struct {
+	core.Blockchain
+
+	blocks   []*types.Block
+	receipts map[common.Hash]types.Receipts
+	pending  map[common.Address]types.Transactions
+	earliest uint64
+}
+
+// addBlock adds a block with the given transactions to the chain.
+func (c *mockGPOChain) addBlock(txs []testTx) *types.Block {
+	header := &types.Header{
+		Number:   big.NewInt(int64(len(c.blocks))),
+		GasLimit: testGasLimit,
+		BaseFee:  big.NewInt(testBaseFee),
+	}
+	var (
+		blockTxs types.Transactions
+		receipts types.Receipts
+	)
+	for i, tx := range txs {
+		blockTx := newTestTx(uint64(i), tx)
+		blockTxs = append(blockTxs, blockTx)
+		receipts = append(receipts, &types.Receipt{TxHash: blockTx.Hash(), GasUsed: uint64(tx.gas)})
+		header.GasUsed += uint64(tx.gas)
+	}
+	block := types.NewBlock(header, blockTxs, nil, receipts, trie.NewStackTrie(nil))
+	c.blocks = append(c.blocks, block)
+	c.receipts[block.Hash()] = receipts
+	return block
+}
+
+// addPending adds the given transactions to the pending transactions of the pool.
+func (c *mockGPOChain) addPending(txs []testTx) {
+	c.pending = map[common.Address]types.Transactions{}
+	for i, tx := range txs {
+		sender := common.BytesToAddress([]byte{byte(i + 1)})
+		c.pending[sender] = types.Transactions{newTestTx(0, tx)}
+	}
+}
+
+// nextBaseFee returns the base fee of the block following the head.
+func (c *mockGPOChain) nextBaseFee() *big.Int {
+	return misc.CalcBaseFee(c.Config(), c.CurrentHeader())
+}
+
+func (c *mockGPOChain) Config() *params.ChainConfig {
+	return params.DefaultChainConfig
+}
+
+func (c *mockGPOChain) CurrentHeader() *types.Header {
+	return c.CurrentFinalBlock()
+}
+
+func (c *mockGPOChain) CurrentFinalBlock() *types.Header {
+	if len(c.blocks) == 0 {
+		return nil
+	}
+	return c.blocks[len(c.blocks)-1].Header()
+}
+
+func (c *mockGPOChain) GetBlockByNumber(number uint64) *types.Block {
+	if number < c.earliest || number >= uint64(len(c.blocks)) {
+		return nil
+	}
+	return c.blocks[number]
+}
+
+func (c *mockGPOChain) GetReceiptsByHash(hash common.Hash) types.Receipts {
+	return c.receipts[hash]
+}
+
+func (c *mockGPOChain) GetPoolContent() (
+	map[common.Address]types.Transactions, map[common.Address]types.Transactions,
+) {
+	return c.pending, nil
+}
+
+func (c *mockGPOChain) EarliestBlockNumber() uint64 {
+	return c.earliest
+}
+
+// newTestTx returns a dynamic fee transaction that pays the given effective tip at the test base
+// fee and has the given gas limit.
+func newTestTx(nonce uint64, tx testTx) *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		Nonce:     nonce,
+		Gas:       uint64(tx.gas),
+		GasTipCap: big.NewInt(tx.tip),
+		GasFeeCap: big.NewInt(testBaseFee + tx.tip),
+	})
+}
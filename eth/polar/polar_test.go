@@ -18,6 +18,16 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package miner
+package polar
 
-// coming soon tm
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPolar(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "eth/polar")
+}
@@ -25,13 +25,19 @@ import (
 	"os"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/graphql"
 
+	// Register the JS and native tracers for use by the debug API.
+	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+
 	"pkg.berachain.dev/polaris/eth/core"
 	"pkg.berachain.dev/polaris/eth/log"
+	"pkg.berachain.dev/polaris/eth/miner"
 	polarapi "pkg.berachain.dev/polaris/eth/polar/api"
 	"pkg.berachain.dev/polaris/eth/rpc"
 )
@@ -60,6 +66,20 @@ type NetworkingStack interface {
 	Start() error
 }
 
+// StatusProvider defines methods that allow a Polaris chain to report the syncing and p2p status
+// of the host chain node, which Polaris has no knowledge of.
+type StatusProvider interface {
+	// SyncProgress returns the progress of the host chain node catching up with the network, the
+	// node is synced if its current block is at least the highest block.
+	SyncProgress() ethereum.SyncProgress
+
+	// Listening returns whether the host chain node is listening for p2p connections.
+	Listening() bool
+
+	// PeerCount returns the number of p2p peers connected to the host chain node.
+	PeerCount() uint64
+}
+
 // Polaris is the only object that an implementing chain should use.
 type Polaris struct {
 	cfg *Config
@@ -71,9 +91,19 @@ type Polaris struct {
 	// blockchain represents the canonical chain.
 	blockchain core.Blockchain
 
+	// miner simulates the pending block from the transactions in the mempool.
+	miner *miner.Miner
+
+	// statusProvider reports the syncing and p2p status of the host chain node, it is nil if the
+	// host chain did not provide one.
+	statusProvider StatusProvider
+
 	// backend is utilize by the api handlers as a middleware between the JSON-RPC APIs and the blockchain.
 	backend Backend
 
+	// bloomIndexer builds and serves the bloombits index used to filter logs over large ranges.
+	bloomIndexer *bloomIndexer
+
 	// filterSystem is the filter system that is used by the filter API.
 	// TODO: relocate
 	filterSystem *filters.FilterSystem
@@ -101,19 +131,49 @@ func NewWithNetworkingStack(
 		log.Root().SetHandler(logHandler)
 	}
 
+	// Build the bloombits indexer, which is backed by a dedicated database.
+	var err error
+	if pl.bloomIndexer, err = newBloomIndexer(pl.blockchain, stack); err != nil {
+		panic(err)
+	}
+
+	// Build the miner, which simulates the pending block on top of the chain.
+	pl.miner = miner.New(pl.blockchain)
+
 	// Build and set the RPC Backend.
 	pl.backend = NewBackend(pl, stack.ExtRPCEnabled(), cfg)
 	return pl
 }
 
+// SetStatusProvider sets the provider of the syncing and p2p status of the host chain node, which
+// is reported by the `eth_syncing`, `net_listening` and `net_peerCount` RPCs. It must be called
+// before `StartServices`.
+func (pl *Polaris) SetStatusProvider(sp StatusProvider) {
+	pl.statusProvider = sp
+}
+
+// Backend returns the backend of the JSON-RPC APIs, which allows the host chain to serve them over
+// other transports.
+func (pl *Polaris) Backend() Backend {
+	return pl.backend
+}
+
 // APIs return the collection of RPC services the polar package offers.
 // NOTE, some of these services probably need to be moved to somewhere else.
 func (pl *Polaris) APIs() []rpc.API {
 	// Grab a bunch of the apis from go-ethereum (thx bae)
 	apis := polarapi.GethAPIs(pl.backend, pl.blockchain)
 
+	// Add the debug tracing apis (debug_traceTransaction, debug_traceBlock, debug_traceCall, etc).
+	apis = append(apis, polarapi.TracerAPIs(pl.backend)...)
+
 	// Append all the local APIs and return
 	return append(apis, []rpc.API{
+		{
+			// Overrides the go-ethereum implementation of `eth_getProof`, which relies on a state trie.
+			Namespace: "eth",
+			Service:   polarapi.NewProofAPI(pl.backend),
+		},
 		{
 			Namespace: "net",
 			Service:   polarapi.NewNetAPI(pl.backend),
@@ -127,6 +187,12 @@ func (pl *Polaris) APIs() []rpc.API {
 
 // StartServices notifies the NetworkStack to spin up (i.e json-rpc).
 func (pl *Polaris) StartServices() error {
+	// Start indexing the bloombits of the chain.
+	pl.bloomIndexer.Start()
+
+	// Start simulating the pending block.
+	pl.miner.Start()
+
 	// Register the JSON-RPCs with the networking stack.
 	pl.stack.RegisterAPIs(pl.APIs())
 
@@ -148,3 +214,12 @@ func (pl *Polaris) StartServices() error {
 	}()
 	return nil
 }
+
+// StopServices stops the services of Polaris that run in the background and releases their
+// resources.
+func (pl *Polaris) StopServices() error {
+	// Stop simulating the pending block.
+	pl.miner.Stop()
+
+	return pl.bloomIndexer.Close()
+}
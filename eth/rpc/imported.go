@@ -32,10 +32,11 @@ type (
 )
 
 var (
-	NewServer            = rpc.NewServer
-	SafeBlockNumber      = rpc.SafeBlockNumber
-	FinalizedBlockNumber = rpc.FinalizedBlockNumber
-	LatestBlockNumber    = rpc.LatestBlockNumber
-	PendingBlockNumber   = rpc.PendingBlockNumber
-	EarliestBlockNumber  = rpc.EarliestBlockNumber
+	NewServer                   = rpc.NewServer
+	BlockNumberOrHashWithNumber = rpc.BlockNumberOrHashWithNumber
+	SafeBlockNumber             = rpc.SafeBlockNumber
+	FinalizedBlockNumber        = rpc.FinalizedBlockNumber
+	LatestBlockNumber           = rpc.LatestBlockNumber
+	PendingBlockNumber          = rpc.PendingBlockNumber
+	EarliestBlockNumber         = rpc.EarliestBlockNumber
 )